@@ -1,22 +1,53 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
+	"fmt"
+	"io"
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
 	"github.com/rxtech-lab/resume-mcp/internal/api"
 	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/events"
+	"github.com/rxtech-lab/resume-mcp/internal/jsonresume"
 	"github.com/rxtech-lab/resume-mcp/internal/mcp"
 	"github.com/rxtech-lab/resume-mcp/internal/service"
 )
 
+// commands are run instead of the MCP server when named by the first argument
+var commands = map[string]func(args []string) error{
+	"import-json": importJSONCommand,
+	"export-json": exportJSONCommand,
+}
+
 func main() {
+	if len(os.Args) > 1 {
+		if command, ok := commands[os.Args[1]]; ok {
+			if err := command(os.Args[2:]); err != nil {
+				log.SetFlags(0)
+				log.Fatalf("%s: %v", os.Args[1], err)
+			}
+			return
+		}
+	}
+
 	// get port from cmd line
 	port := flag.String("port", "0", "Port to listen on (0 for any available port)")
+	toolProfile := flag.String("tool-profile", mcp.ProfileFull, "Tool profile to expose: full or read-only")
+	allowTools := flag.String("allow-tools", "", "Comma separated list of tools to expose, overrides the tool profile")
+	denyTools := flag.String("deny-tools", "", "Comma separated list of tools to hide")
 	flag.Parse()
+
+	profile, err := mcp.NewToolProfile(*toolProfile, *allowTools, *denyTools)
+	if err != nil {
+		log.Fatal("Invalid tool configuration:", err)
+	}
+
 	homePath, err := os.UserHomeDir()
 	if err != nil {
 		log.Fatal("Failed to get home directory:", err)
@@ -29,9 +60,10 @@ func main() {
 	defer db.Close()
 
 	templateService := service.NewTemplateService()
+	broker := events.NewBroker()
 
 	// Create API server first
-	apiServer := api.NewAPIServer(db, templateService)
+	apiServer := api.NewAPIServer(db, templateService, broker)
 	apiServer.SetupRoutes()
 
 	// Start API server and get the actual port
@@ -42,7 +74,9 @@ func main() {
 	}
 
 	// Create MCP server with the actual port
-	mcpServer := mcp.NewMCPServer(db, actualPort, templateService)
+	mcpServer := mcp.NewMCPServer(db, actualPort, templateService, broker)
+	mcpServer.SetToolConfig(mcp.ToolConfig{Default: profile})
+	mcpServer.AllowLocalFiles()
 
 	go func() {
 		if err := mcpServer.Start(); err != nil {
@@ -62,3 +96,123 @@ func main() {
 		log.Printf("Error shutting down API server: %v", err)
 	}
 }
+
+// commandFlags returns the flags shared by the commands, the database path and the user owning the resumes
+func commandFlags(name string) (*flag.FlagSet, *string, *string) {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	dbPath := flags.String("db", "", "Path of the database (default ~/resume.db)")
+	user := flags.String("user", "", "ID of the user owning the resumes (default: none, as in stdio mode)")
+	return flags, dbPath, user
+}
+
+func openCommandDatabase(dbPath, user string) (*database.Database, *string, error) {
+	if dbPath == "" {
+		homePath, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get home directory: %w", err)
+		}
+		dbPath = homePath + "/resume.db"
+	}
+
+	db, err := database.NewDatabase(dbPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == "" {
+		return db, nil, nil
+	}
+	return db, &user, nil
+}
+
+// importJSONCommand creates a resume from a JSON Resume file, or standard input when the file is -
+func importJSONCommand(args []string) error {
+	flags, dbPath, user := commandFlags("import-json")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: resume-mcp import-json [-db path] [-user id] <file|->")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var data []byte
+	var err error
+	if flags.Arg(0) == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(flags.Arg(0))
+	}
+	if err != nil {
+		return err
+	}
+
+	document, warnings, err := jsonresume.Parse(data)
+	if err != nil {
+		return err
+	}
+	resume, err := jsonresume.Import(document)
+	if err != nil {
+		return err
+	}
+
+	db, userID, err := openCommandDatabase(*dbPath, *user)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := db.ImportResume(resume, userID); err != nil {
+		return fmt.Errorf("failed to create resume: %w", err)
+	}
+
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, "Warning:", warning)
+	}
+	fmt.Printf("Imported resume %q with ID %d\n", resume.Name, resume.ID)
+	return nil
+}
+
+// exportJSONCommand writes a resume as JSON Resume document to standard output or a file
+func exportJSONCommand(args []string) error {
+	flags, dbPath, user := commandFlags("export-json")
+	output := flags.String("o", "", "File to write the document to (default: standard output)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: resume-mcp export-json [-db path] [-user id] [-o file] <resume-id>")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	resumeID, err := strconv.ParseUint(flags.Arg(0), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid resume ID: %w", err)
+	}
+
+	db, userID, err := openCommandDatabase(*dbPath, *user)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	resume, err := db.GetResumeByID(uint(resumeID), userID)
+	if err != nil {
+		return fmt.Errorf("failed to get resume: %w", err)
+	}
+
+	data, err := json.MarshalIndent(jsonresume.Export(*resume), "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if *output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(*output, data, 0644)
+}
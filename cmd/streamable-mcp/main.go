@@ -8,6 +8,7 @@ import (
 
 	"github.com/rxtech-lab/resume-mcp/internal/api"
 	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/events"
 	"github.com/rxtech-lab/resume-mcp/internal/mcp"
 	"github.com/rxtech-lab/resume-mcp/internal/service"
 )
@@ -17,6 +18,16 @@ func main() {
 	if port == "" {
 		port = "8080"
 	}
+	profile, err := mcp.NewToolProfile(os.Getenv("TOOL_PROFILE"), os.Getenv("ALLOW_TOOLS"), os.Getenv("DENY_TOOLS"))
+	if err != nil {
+		log.Fatal("Invalid tool configuration:", err)
+	}
+	// e.g. ROLE_TOOL_PROFILES="admin=full,viewer=read-only;deny=generate_preview"
+	roleProfiles, err := mcp.ParseRoleProfiles(os.Getenv("ROLE_TOOL_PROFILES"))
+	if err != nil {
+		log.Fatal("Invalid role tool profiles:", err)
+	}
+
 	db, err := database.NewPostgresDatabase(os.Getenv("POSTGRES_URL"))
 	if err != nil {
 		log.Fatal("Failed to initialize database:", err)
@@ -24,12 +35,14 @@ func main() {
 	defer db.Close()
 
 	templateService := service.NewTemplateService()
+	broker := events.NewBroker()
 
 	// Create API server first
-	apiServer := api.NewAPIServer(db, templateService)
+	apiServer := api.NewAPIServer(db, templateService, broker)
 
 	// Create MCP server with the actual port
-	mcpServer := mcp.NewMCPServer(db, port, templateService)
+	mcpServer := mcp.NewMCPServer(db, port, templateService, broker)
+	mcpServer.SetToolConfig(mcp.ToolConfig{Default: profile, Roles: roleProfiles})
 	streamableServer := mcpServer.StartStreamable()
 	apiServer.SetupStreamableServer(streamableServer)
 	apiServer.SetupRoutes()
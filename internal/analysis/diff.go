@@ -0,0 +1,225 @@
+package analysis
+
+import (
+	"fmt"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// ResumeDiff lists the differences between two resumes, grouped by entity type
+type ResumeDiff struct {
+	Identical        bool          `json:"identical"`
+	BasicInfo        []FieldChange `json:"basic_info"`
+	Contacts         EntityDiff    `json:"contacts"`
+	WorkExperiences  EntityDiff    `json:"work_experiences"`
+	Educations       EntityDiff    `json:"educations"`
+	OtherExperiences EntityDiff    `json:"other_experiences"`
+}
+
+// EntityDiff holds the entities that only exist in one resume and the ones that exist in both but differ.
+// Entities are identified by their natural key, e.g. company and job title, never by database ID.
+type EntityDiff struct {
+	Added   []EntityEntry  `json:"added"`
+	Removed []EntityEntry  `json:"removed"`
+	Changed []EntityChange `json:"changed"`
+}
+
+// EntityEntry is an entity present in only one of the resumes
+type EntityEntry struct {
+	Key    string `json:"key"`
+	Entity any    `json:"entity"`
+}
+
+// EntityChange is an entity present in both resumes with different fields or feature maps
+type EntityChange struct {
+	Key         string        `json:"key"`
+	Fields      []FieldChange `json:"fields,omitempty"`
+	FeatureMaps *EntityDiff   `json:"feature_maps,omitempty"`
+}
+
+// FieldChange is a single field whose value differs between the resumes
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// entity is the comparable form of a resume entry
+type entity struct {
+	key         string
+	value       any
+	fields      [][2]string // field name and value, in output order
+	featureMaps []models.FeatureMap
+}
+
+// DiffResumes compares resume a with resume b. Added entries exist only in b, removed entries only in a.
+func DiffResumes(a, b *models.Resume) ResumeDiff {
+	diff := ResumeDiff{
+		BasicInfo:        diffFields(basicInfoFields(a), basicInfoFields(b)),
+		Contacts:         diffEntities(contactEntities(a.Contacts), contactEntities(b.Contacts)),
+		WorkExperiences:  diffEntities(workExperienceEntities(a.WorkExperiences), workExperienceEntities(b.WorkExperiences)),
+		Educations:       diffEntities(educationEntities(a.Educations), educationEntities(b.Educations)),
+		OtherExperiences: diffEntities(otherExperienceEntities(a.OtherExperiences), otherExperienceEntities(b.OtherExperiences)),
+	}
+	diff.Identical = len(diff.BasicInfo) == 0 &&
+		diff.Contacts.empty() &&
+		diff.WorkExperiences.empty() &&
+		diff.Educations.empty() &&
+		diff.OtherExperiences.empty()
+	return diff
+}
+
+func (d EntityDiff) empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+func basicInfoFields(resume *models.Resume) [][2]string {
+	return [][2]string{
+		{"name", resume.Name},
+		{"photo", resume.Photo},
+		{"description", resume.Description},
+	}
+}
+
+func contactEntities(contacts []models.Contact) []entity {
+	entities := make([]entity, 0, len(contacts))
+	for _, contact := range contacts {
+		entities = append(entities, entity{
+			key:   contact.Key,
+			value: contact,
+			fields: [][2]string{
+				{"value", contact.Value},
+				{"category", contact.Category},
+			},
+		})
+	}
+	return entities
+}
+
+func workExperienceEntities(experiences []models.WorkExperience) []entity {
+	entities := make([]entity, 0, len(experiences))
+	for _, experience := range experiences {
+		entities = append(entities, entity{
+			key:   experience.Company + " / " + experience.JobTitle,
+			value: experience,
+			fields: [][2]string{
+				{"type", experience.Type},
+				{"start_date", experience.Start().String()},
+				{"end_date", experience.End().String()},
+				{"category", experience.Category},
+			},
+			featureMaps: experience.FeatureMaps,
+		})
+	}
+	return entities
+}
+
+func educationEntities(educations []models.Education) []entity {
+	entities := make([]entity, 0, len(educations))
+	for _, education := range educations {
+		entities = append(entities, entity{
+			key:   education.SchoolName,
+			value: education,
+			fields: [][2]string{
+				{"type", education.Type},
+				{"start_date", education.Start().String()},
+				{"end_date", education.End().String()},
+				{"category", education.Category},
+			},
+			featureMaps: education.FeatureMaps,
+		})
+	}
+	return entities
+}
+
+func otherExperienceEntities(experiences []models.OtherExperience) []entity {
+	entities := make([]entity, 0, len(experiences))
+	for _, experience := range experiences {
+		entities = append(entities, entity{
+			key:         experience.Category,
+			value:       experience,
+			featureMaps: experience.FeatureMaps,
+		})
+	}
+	return entities
+}
+
+func featureMapEntities(featureMaps []models.FeatureMap) []entity {
+	entities := make([]entity, 0, len(featureMaps))
+	for _, featureMap := range featureMaps {
+		entities = append(entities, entity{
+			key:   featureMap.Key,
+			value: featureMap,
+			fields: [][2]string{
+				{"value", featureMap.Value},
+				{"category", featureMap.Category},
+			},
+		})
+	}
+	return entities
+}
+
+// diffEntities matches entities on their natural keys. Entities sharing a key,
+// such as two positions with the same title at one company, are paired in order.
+func diffEntities(a, b []entity) EntityDiff {
+	diff := EntityDiff{
+		Added:   []EntityEntry{},
+		Removed: []EntityEntry{},
+		Changed: []EntityChange{},
+	}
+
+	a, b = uniqueKeys(a), uniqueKeys(b)
+	inB := make(map[string]entity, len(b))
+	for _, e := range b {
+		inB[e.key] = e
+	}
+	inA := make(map[string]bool, len(a))
+
+	for _, old := range a {
+		inA[old.key] = true
+		updated, ok := inB[old.key]
+		if !ok {
+			diff.Removed = append(diff.Removed, EntityEntry{Key: old.key, Entity: old.value})
+			continue
+		}
+
+		change := EntityChange{Key: old.key, Fields: diffFields(old.fields, updated.fields)}
+		if featureMaps := diffEntities(featureMapEntities(old.featureMaps), featureMapEntities(updated.featureMaps)); !featureMaps.empty() {
+			change.FeatureMaps = &featureMaps
+		}
+		if len(change.Fields) > 0 || change.FeatureMaps != nil {
+			diff.Changed = append(diff.Changed, change)
+		}
+	}
+
+	for _, e := range b {
+		if !inA[e.key] {
+			diff.Added = append(diff.Added, EntityEntry{Key: e.key, Entity: e.value})
+		}
+	}
+	return diff
+}
+
+// uniqueKeys suffixes repeated keys with their occurrence number so that every key is unique
+func uniqueKeys(entities []entity) []entity {
+	seen := map[string]int{}
+	unique := make([]entity, len(entities))
+	for i, e := range entities {
+		seen[e.key]++
+		if n := seen[e.key]; n > 1 {
+			e.key = fmt.Sprintf("%s #%d", e.key, n)
+		}
+		unique[i] = e
+	}
+	return unique
+}
+
+func diffFields(a, b [][2]string) []FieldChange {
+	changes := []FieldChange{}
+	for i := range a {
+		if a[i][1] != b[i][1] {
+			changes = append(changes, FieldChange{Field: a[i][0], From: a[i][1], To: b[i][1]})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,141 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func newMasterResume() *models.Resume {
+	endDate := time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC)
+	return &models.Resume{
+		ID:   1,
+		Name: "Master",
+		Contacts: []models.Contact{
+			{ID: 1, Key: "email", Value: "john@example.com"},
+			{ID: 2, Key: "phone", Value: "123"},
+		},
+		WorkExperiences: []models.WorkExperience{
+			{
+				ID:        1,
+				Company:   "Tech Corp",
+				JobTitle:  "Engineer",
+				StartDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   &endDate,
+				FeatureMaps: []models.FeatureMap{
+					{ID: 1, Key: "description", Value: "Built APIs"},
+					{ID: 2, Key: "skills", Value: "Go"},
+				},
+			},
+		},
+		Educations: []models.Education{
+			{ID: 1, SchoolName: "MIT", StartDate: time.Date(2016, 9, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		OtherExperiences: []models.OtherExperience{
+			{ID: 1, Category: "Projects"},
+		},
+	}
+}
+
+func TestDiffResumes_Identical(t *testing.T) {
+	a := newMasterResume()
+	b := newMasterResume()
+	// Database IDs never take part in matching
+	b.ID = 2
+	b.Contacts[0].ID = 10
+	b.WorkExperiences[0].ID = 20
+
+	diff := DiffResumes(a, b)
+	if !diff.Identical {
+		t.Errorf("Expected identical resumes, got %+v", diff)
+	}
+}
+
+func TestDiffResumes_Changes(t *testing.T) {
+	a := newMasterResume()
+	b := newMasterResume()
+	b.Name = "Tailored"
+	b.Contacts = b.Contacts[:1]
+	b.Contacts = append(b.Contacts, models.Contact{Key: "website", Value: "example.com"})
+	b.WorkExperiences[0].EndDate = nil
+	b.WorkExperiences[0].FeatureMaps[1].Value = "Go, Rust"
+	b.WorkExperiences[0].FeatureMaps = append(b.WorkExperiences[0].FeatureMaps, models.FeatureMap{Key: "impact", Value: "+20%"})
+	b.Educations = append(b.Educations, models.Education{SchoolName: "Stanford"})
+	b.OtherExperiences = nil
+
+	diff := DiffResumes(a, b)
+	if diff.Identical {
+		t.Fatal("Expected differences")
+	}
+
+	if len(diff.BasicInfo) != 1 || diff.BasicInfo[0] != (FieldChange{Field: "name", From: "Master", To: "Tailored"}) {
+		t.Errorf("Unexpected basic info changes: %+v", diff.BasicInfo)
+	}
+
+	if len(diff.Contacts.Added) != 1 || diff.Contacts.Added[0].Key != "website" {
+		t.Errorf("Expected website contact to be added, got %+v", diff.Contacts.Added)
+	}
+	if len(diff.Contacts.Removed) != 1 || diff.Contacts.Removed[0].Key != "phone" {
+		t.Errorf("Expected phone contact to be removed, got %+v", diff.Contacts.Removed)
+	}
+
+	if len(diff.WorkExperiences.Changed) != 1 {
+		t.Fatalf("Expected one changed work experience, got %+v", diff.WorkExperiences.Changed)
+	}
+	change := diff.WorkExperiences.Changed[0]
+	if change.Key != "Tech Corp / Engineer" {
+		t.Errorf("Expected natural key 'Tech Corp / Engineer', got %s", change.Key)
+	}
+	if len(change.Fields) != 1 || change.Fields[0] != (FieldChange{Field: "end_date", From: "2023-06-30", To: ""}) {
+		t.Errorf("Unexpected field changes: %+v", change.Fields)
+	}
+	if change.FeatureMaps == nil {
+		t.Fatal("Expected feature map changes")
+	}
+	if len(change.FeatureMaps.Added) != 1 || change.FeatureMaps.Added[0].Key != "impact" {
+		t.Errorf("Expected impact feature map to be added, got %+v", change.FeatureMaps.Added)
+	}
+	if len(change.FeatureMaps.Changed) != 1 || change.FeatureMaps.Changed[0].Key != "skills" {
+		t.Errorf("Expected skills feature map to change, got %+v", change.FeatureMaps.Changed)
+	}
+
+	if len(diff.Educations.Added) != 1 || diff.Educations.Added[0].Key != "Stanford" {
+		t.Errorf("Expected Stanford to be added, got %+v", diff.Educations.Added)
+	}
+	if len(diff.OtherExperiences.Removed) != 1 || diff.OtherExperiences.Removed[0].Key != "Projects" {
+		t.Errorf("Expected Projects to be removed, got %+v", diff.OtherExperiences.Removed)
+	}
+}
+
+func TestDiffResumes_DuplicateKeys(t *testing.T) {
+	a := newMasterResume()
+	b := newMasterResume()
+	second := b.WorkExperiences[0]
+	second.FeatureMaps = nil
+	b.WorkExperiences = append(b.WorkExperiences, second)
+
+	diff := DiffResumes(a, b)
+	if len(diff.WorkExperiences.Added) != 1 || diff.WorkExperiences.Added[0].Key != "Tech Corp / Engineer #2" {
+		t.Errorf("Expected the second position to be added, got %+v", diff.WorkExperiences.Added)
+	}
+	if len(diff.WorkExperiences.Changed) != 0 {
+		t.Errorf("Expected the first position to match, got %+v", diff.WorkExperiences.Changed)
+	}
+}
+
+func TestDiffResumes_DatePrecision(t *testing.T) {
+	a := newMasterResume()
+	b := newMasterResume()
+	// Same stored date, only the precision changes
+	b.WorkExperiences[0].StartDatePrecision = models.DatePrecisionYear
+	b.Educations[0].StartDatePrecision = models.DatePrecisionMonth
+
+	diff := DiffResumes(a, b)
+	if len(diff.WorkExperiences.Changed) != 1 || diff.WorkExperiences.Changed[0].Fields[0] != (FieldChange{Field: "start_date", From: "2020-01-01", To: "2020"}) {
+		t.Errorf("Expected the start date to change to a year, got %+v", diff.WorkExperiences.Changed)
+	}
+	if len(diff.Educations.Changed) != 1 || diff.Educations.Changed[0].Fields[0] != (FieldChange{Field: "start_date", From: "2016-09-01", To: "2016-09"}) {
+		t.Errorf("Expected the start date to change to a month, got %+v", diff.Educations.Changed)
+	}
+}
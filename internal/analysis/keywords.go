@@ -0,0 +1,253 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// DefaultMaxKeywords is the number of job description keywords analyzed when no limit is given
+const DefaultMaxKeywords = 40
+
+// stopWords are common English words and job posting filler that never count as keywords
+var stopWords = toSet(`
+a about above after again against all also am an and any are as at be because been before being below between both
+but by can could did do does doing down during each either etc even ever every few for from further get gets got
+had has have having he her here hers herself him himself his how i if in into is it its itself just let like made
+make many may me might more most much must my myself no nor not now of off on once one only or other our ours
+ourselves out over own per please same she should so some such than that the their theirs them themselves then
+there these they this those through to too under until up upon us very via was we were what when where which
+while who whom whose why will with within without would yet you your yours yourself yourselves
+ability able across activities along among apply applicant applicants bonus benefits candidate candidates
+closely company compensation competitive contribute day days degree demonstrated desired duties environment
+equal equivalent etc excellent experience experienced familiarity familiar field including help highly ideal
+ideally join key knowledge least level looking new offer opportunity opportunities part plus position preferred
+proven qualifications related required requirement requirements responsibilities responsible role salary seeking
+skill skills strong successful team teams understanding using well work working year years
+`)
+
+// KeywordMatch is a job description keyword and where the resume covers it
+type KeywordMatch struct {
+	Term    string   `json:"term"`
+	Count   int      `json:"count"`
+	FoundIn []string `json:"found_in,omitempty"`
+}
+
+// JobMatch is the keyword coverage of a resume against a job description
+type JobMatch struct {
+	// Score is the share of keyword occurrences covered by the resume, from 0 to 100
+	Score    float64        `json:"score"`
+	Keywords int            `json:"keywords"`
+	Covered  []KeywordMatch `json:"covered"`
+	Missing  []KeywordMatch `json:"missing"`
+}
+
+// keyword is a term extracted from a job description
+type keyword struct {
+	key   string // normalized form used for matching
+	term  string // most common surface form
+	count int
+}
+
+// AnalyzeJobMatch extracts the most frequent keywords and two word phrases from the job description
+// and checks which of them appear in the resume's titles, descriptions and feature maps
+func AnalyzeJobMatch(resume *models.Resume, jobDescription string, maxKeywords int) JobMatch {
+	if maxKeywords <= 0 {
+		maxKeywords = DefaultMaxKeywords
+	}
+	keywords := extractKeywords(jobDescription, maxKeywords)
+	sources := resumeSources(resume)
+
+	match := JobMatch{
+		Keywords: len(keywords),
+		Covered:  []KeywordMatch{},
+		Missing:  []KeywordMatch{},
+	}
+
+	total, covered := 0, 0
+	for _, k := range keywords {
+		entry := KeywordMatch{Term: k.term, Count: k.count}
+		for _, source := range sources {
+			if source.terms[k.key] {
+				entry.FoundIn = append(entry.FoundIn, source.name)
+			}
+		}
+
+		total += k.count
+		if len(entry.FoundIn) > 0 {
+			covered += k.count
+			match.Covered = append(match.Covered, entry)
+		} else {
+			match.Missing = append(match.Missing, entry)
+		}
+	}
+
+	if total > 0 {
+		match.Score = math.Round(float64(covered)/float64(total)*1000) / 10
+	}
+	return match
+}
+
+// extractKeywords returns up to limit keywords ordered by frequency. Single words are
+// kept when they aren't stop words; two word phrases only when they appear more than once.
+func extractKeywords(text string, limit int) []keyword {
+	counts := map[string]*keyword{}
+	surface := map[string]map[string]int{}
+
+	add := func(key, term string) {
+		k, ok := counts[key]
+		if !ok {
+			k = &keyword{key: key}
+			counts[key] = k
+			surface[key] = map[string]int{}
+		}
+		k.count++
+		surface[key][term]++
+	}
+
+	for _, sentence := range splitSentences(text) {
+		tokens := tokenize(sentence)
+		for i, token := range tokens {
+			if isKeyword(token) {
+				add(normalize(token), token)
+			}
+			if i > 0 && isKeyword(tokens[i-1]) && isKeyword(token) {
+				add(normalize(tokens[i-1])+" "+normalize(token), tokens[i-1]+" "+token)
+			}
+		}
+	}
+
+	keywords := make([]keyword, 0, len(counts))
+	for key, k := range counts {
+		if strings.Contains(key, " ") && k.count < 2 {
+			continue
+		}
+		k.term = mostCommon(surface[key])
+		keywords = append(keywords, *k)
+	}
+
+	sort.Slice(keywords, func(i, j int) bool {
+		if keywords[i].count != keywords[j].count {
+			return keywords[i].count > keywords[j].count
+		}
+		return keywords[i].key < keywords[j].key
+	})
+	if len(keywords) > limit {
+		keywords = keywords[:limit]
+	}
+	return keywords
+}
+
+// source is a part of the resume with the normalized words and phrases it contains
+type source struct {
+	name  string
+	terms map[string]bool
+}
+
+func resumeSources(resume *models.Resume) []source {
+	sources := []source{newSource("description", resume.Description)}
+
+	for _, experience := range resume.WorkExperiences {
+		texts := []string{experience.JobTitle, experience.Company}
+		sources = append(sources, newSource(experience.Company+" / "+experience.JobTitle, append(texts, featureMapTexts(experience.FeatureMaps)...)...))
+	}
+	for _, education := range resume.Educations {
+		sources = append(sources, newSource(education.SchoolName, append([]string{education.SchoolName}, featureMapTexts(education.FeatureMaps)...)...))
+	}
+	for _, experience := range resume.OtherExperiences {
+		sources = append(sources, newSource(experience.Category, append([]string{experience.Category}, featureMapTexts(experience.FeatureMaps)...)...))
+	}
+	return sources
+}
+
+func featureMapTexts(featureMaps []models.FeatureMap) []string {
+	texts := make([]string, 0, len(featureMaps)*2)
+	for _, featureMap := range featureMaps {
+		texts = append(texts, featureMap.Key, featureMap.Value)
+	}
+	return texts
+}
+
+func newSource(name string, texts ...string) source {
+	terms := map[string]bool{}
+	for _, text := range texts {
+		for _, sentence := range splitSentences(text) {
+			tokens := tokenize(sentence)
+			for i, token := range tokens {
+				terms[normalize(token)] = true
+				if i > 0 {
+					terms[normalize(tokens[i-1])+" "+normalize(token)] = true
+				}
+			}
+		}
+	}
+	return source{name: name, terms: terms}
+}
+
+// splitSentences splits text on line breaks and sentence punctuation so phrases don't span sentences
+func splitSentences(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return r == '\n' || r == ';' || r == ',' || r == '(' || r == ')' || r == ':' || r == '!' || r == '?' || r == '•'
+	})
+}
+
+// tokenize lowercases text and splits it into words. Characters used in technology
+// names such as C++, C#, Node.js and CI/CD stay part of the word.
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("+#./-", r)
+	})
+
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.Trim(word, ".-/")
+		if word != "" {
+			tokens = append(tokens, word)
+		}
+	}
+	return tokens
+}
+
+func isKeyword(token string) bool {
+	if len([]rune(token)) < 2 || stopWords[token] {
+		return false
+	}
+	for _, r := range token {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize reduces simple plurals so that "APIs" matches "API"
+func normalize(token string) string {
+	switch {
+	case len(token) > 4 && strings.HasSuffix(token, "ies"):
+		return strings.TrimSuffix(token, "ies") + "y"
+	case len(token) > 3 && strings.HasSuffix(token, "s") && !strings.HasSuffix(token, "ss") && !strings.HasSuffix(token, "us"):
+		return strings.TrimSuffix(token, "s")
+	}
+	return token
+}
+
+func mostCommon(counts map[string]int) string {
+	best, bestCount := "", 0
+	for term, count := range counts {
+		if count > bestCount || (count == bestCount && term < best) {
+			best, bestCount = term, count
+		}
+	}
+	return best
+}
+
+func toSet(words string) map[string]bool {
+	set := map[string]bool{}
+	for _, word := range strings.Fields(words) {
+		set[word] = true
+	}
+	return set
+}
@@ -0,0 +1,101 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{text: "Go, Python and C++.", expected: []string{"go", "python", "and", "c++"}},
+		{text: "Node.js / CI/CD", expected: []string{"node.js", "ci/cd"}},
+		{text: "C# - REST APIs", expected: []string{"c#", "rest", "apis"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			tokens := tokenize(tt.text)
+			if len(tokens) != len(tt.expected) {
+				t.Fatalf("tokenize(%q) = %v, want %v", tt.text, tokens, tt.expected)
+			}
+			for i := range tokens {
+				if tokens[i] != tt.expected[i] {
+					t.Errorf("tokenize(%q) = %v, want %v", tt.text, tokens, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractKeywords(t *testing.T) {
+	text := `We are looking for an engineer with strong experience in Kubernetes and Go.
+You will design REST APIs and run Kubernetes clusters. Machine learning is a plus.
+Experience with machine learning pipelines and the REST API design is required.`
+
+	keywords := extractKeywords(text, 10)
+	found := map[string]int{}
+	for _, k := range keywords {
+		found[k.key] = k.count
+	}
+
+	if found["kubernete"] != 2 {
+		t.Errorf("Expected kubernetes twice, got %v", found)
+	}
+	if found["machine learning"] != 2 {
+		t.Errorf("Expected phrase 'machine learning' twice, got %v", found)
+	}
+	if found["api"] != 2 {
+		t.Errorf("Expected APIs and API to count as one keyword, got %v", found)
+	}
+	for _, stopWord := range []string{"experience", "the", "strong", "required"} {
+		if _, ok := found[stopWord]; ok {
+			t.Errorf("Expected stop word %q to be skipped", stopWord)
+		}
+	}
+	if _, ok := found["run kubernete"]; ok {
+		t.Error("Expected phrases seen once to be skipped")
+	}
+}
+
+func TestAnalyzeJobMatch(t *testing.T) {
+	resume := &models.Resume{
+		WorkExperiences: []models.WorkExperience{
+			{
+				Company:  "Tech Corp",
+				JobTitle: "Backend Engineer",
+				FeatureMaps: []models.FeatureMap{
+					{Key: "description", Value: "Built REST APIs in Go"},
+				},
+			},
+		},
+	}
+
+	match := AnalyzeJobMatch(resume, "Backend engineer. Go, Go, Go. REST API. Kubernetes.", 0)
+
+	covered := map[string]KeywordMatch{}
+	for _, k := range match.Covered {
+		covered[k.Term] = k
+	}
+	if k, ok := covered["go"]; !ok || k.Count != 3 || k.FoundIn[0] != "Tech Corp / Backend Engineer" {
+		t.Errorf("Expected go to be covered by the work experience, got %+v", match.Covered)
+	}
+	if len(match.Missing) != 1 || match.Missing[0].Term != "kubernetes" {
+		t.Errorf("Expected only kubernetes to be missing, got %+v", match.Missing)
+	}
+
+	// backend, engineer, go x3, rest, api covered; kubernetes missing
+	if match.Score != 87.5 {
+		t.Errorf("Expected score 87.5, got %v", match.Score)
+	}
+}
+
+func TestAnalyzeJobMatch_EmptyDescription(t *testing.T) {
+	match := AnalyzeJobMatch(&models.Resume{}, "", 0)
+	if match.Score != 0 || match.Keywords != 0 {
+		t.Errorf("Expected empty match, got %+v", match)
+	}
+}
@@ -0,0 +1,410 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// Severity levels of lint findings, from most to least important
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// Entity types that findings point at
+const (
+	EntityResume          = "resume"
+	EntityWorkExperience  = "work_experience"
+	EntityEducation       = "education"
+	EntityOtherExperience = "other_experience"
+	EntityFeatureMap      = "feature_map"
+)
+
+var severityRank = map[string]int{
+	SeverityError:   0,
+	SeverityWarning: 1,
+	SeverityInfo:    2,
+}
+
+// Finding is a single problem found by a lint rule
+type Finding struct {
+	Rule       string `json:"rule"`
+	Severity   string `json:"severity"`
+	EntityType string `json:"entity_type"`
+	EntityID   uint   `json:"entity_id"`
+	Message    string `json:"message"`
+}
+
+// Rule checks a resume for one kind of problem
+type Rule interface {
+	Name() string
+	Description() string
+	Check(resume *models.Resume) []Finding
+}
+
+// DefaultRules returns the rules run by lint_resume
+func DefaultRules() []Rule {
+	return []Rule{
+		BulletWithoutNumberRule{},
+		WeakOpeningVerbRule{},
+		LongBulletRule{MaxWords: 35},
+		EndBeforeStartRule{},
+		OverlappingFullTimeRule{Tolerance: 31 * 24 * time.Hour},
+		UnexplainedGapRule{MinGap: 183 * 24 * time.Hour},
+		DuplicateFeatureKeyRule{},
+		EmptySectionRule{},
+	}
+}
+
+// SelectRules returns the rules with the given names, or an error naming the first unknown rule
+func SelectRules(rules []Rule, names []string) ([]Rule, error) {
+	byName := make(map[string]Rule, len(rules))
+	for _, rule := range rules {
+		byName[rule.Name()] = rule
+	}
+
+	selected := make([]Rule, 0, len(names))
+	for _, name := range names {
+		rule, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown lint rule: %s", name)
+		}
+		selected = append(selected, rule)
+	}
+	return selected, nil
+}
+
+// Lint runs the rules over the resume and returns the findings, most severe first
+func Lint(resume *models.Resume, rules []Rule) []Finding {
+	findings := []Finding{}
+	for _, rule := range rules {
+		findings = append(findings, rule.Check(resume)...)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return severityRank[findings[i].Severity] < severityRank[findings[j].Severity]
+	})
+	return findings
+}
+
+// bullet is one line of a descriptive feature map value
+type bullet struct {
+	featureMap models.FeatureMap
+	text       string
+}
+
+// nonBulletKeys are feature map keys holding lists or facts rather than sentences
+var nonBulletKeys = toSet(`skills skill technologies technology tech_stack stack tools languages language
+location url link website github gpa degree major minor title team`)
+
+// minBulletWords separates sentences from short values such as "Go, Python"
+const minBulletWords = 4
+
+// experienceBullets returns the sentence-like lines of the feature maps of work and other experiences
+func experienceBullets(resume *models.Resume) []bullet {
+	var featureMaps []models.FeatureMap
+	for _, experience := range resume.WorkExperiences {
+		featureMaps = append(featureMaps, experience.FeatureMaps...)
+	}
+	for _, experience := range resume.OtherExperiences {
+		featureMaps = append(featureMaps, experience.FeatureMaps...)
+	}
+
+	var bullets []bullet
+	for _, featureMap := range featureMaps {
+		if nonBulletKeys[strings.ToLower(featureMap.Key)] {
+			continue
+		}
+		for _, line := range strings.Split(featureMap.Value, "\n") {
+			line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "-*•·"))
+			if len(strings.Fields(line)) >= minBulletWords {
+				bullets = append(bullets, bullet{featureMap: featureMap, text: line})
+			}
+		}
+	}
+	return bullets
+}
+
+// BulletWithoutNumberRule flags bullets that don't quantify their impact
+type BulletWithoutNumberRule struct{}
+
+func (BulletWithoutNumberRule) Name() string { return "bullet-without-number" }
+
+func (BulletWithoutNumberRule) Description() string {
+	return "Bullets should quantify impact with numbers, percentages or amounts"
+}
+
+func (r BulletWithoutNumberRule) Check(resume *models.Resume) []Finding {
+	var findings []Finding
+	for _, b := range experienceBullets(resume) {
+		if !strings.ContainsFunc(b.text, unicode.IsDigit) {
+			findings = append(findings, Finding{
+				Rule:       r.Name(),
+				Severity:   SeverityInfo,
+				EntityType: EntityFeatureMap,
+				EntityID:   b.featureMap.ID,
+				Message:    fmt.Sprintf("Bullet has no numbers: %q", b.text),
+			})
+		}
+	}
+	return findings
+}
+
+// weakVerbs are opening words that describe duties instead of achievements
+var weakVerbs = toSet(`responsible helped help assisted assist worked work participated involved handled tasked
+duties did was were made used utilized supported tried attempted`)
+
+// WeakOpeningVerbRule flags bullets that open with a weak verb such as "Responsible for"
+type WeakOpeningVerbRule struct{}
+
+func (WeakOpeningVerbRule) Name() string { return "weak-opening-verb" }
+
+func (WeakOpeningVerbRule) Description() string {
+	return "Bullets should open with a strong action verb instead of words like \"responsible for\" or \"helped\""
+}
+
+func (r WeakOpeningVerbRule) Check(resume *models.Resume) []Finding {
+	var findings []Finding
+	for _, b := range experienceBullets(resume) {
+		first := strings.ToLower(strings.Trim(strings.Fields(b.text)[0], ".,:;"))
+		if weakVerbs[first] {
+			findings = append(findings, Finding{
+				Rule:       r.Name(),
+				Severity:   SeverityWarning,
+				EntityType: EntityFeatureMap,
+				EntityID:   b.featureMap.ID,
+				Message:    fmt.Sprintf("Bullet opens with the weak verb %q: %q", first, b.text),
+			})
+		}
+	}
+	return findings
+}
+
+// LongBulletRule flags bullets with more than MaxWords words
+type LongBulletRule struct {
+	MaxWords int
+}
+
+func (LongBulletRule) Name() string { return "long-bullet" }
+
+func (r LongBulletRule) Description() string {
+	return fmt.Sprintf("Bullets should be at most %d words long", r.MaxWords)
+}
+
+func (r LongBulletRule) Check(resume *models.Resume) []Finding {
+	var findings []Finding
+	for _, b := range experienceBullets(resume) {
+		if words := len(strings.Fields(b.text)); words > r.MaxWords {
+			findings = append(findings, Finding{
+				Rule:       r.Name(),
+				Severity:   SeverityWarning,
+				EntityType: EntityFeatureMap,
+				EntityID:   b.featureMap.ID,
+				Message:    fmt.Sprintf("Bullet has %d words, more than %d", words, r.MaxWords),
+			})
+		}
+	}
+	return findings
+}
+
+// EndBeforeStartRule flags work experiences and educations that end before they start
+type EndBeforeStartRule struct{}
+
+func (EndBeforeStartRule) Name() string { return "end-before-start" }
+
+func (EndBeforeStartRule) Description() string {
+	return "End dates must not be before start dates"
+}
+
+func (r EndBeforeStartRule) Check(resume *models.Resume) []Finding {
+	var findings []Finding
+	check := func(entityType string, entityID uint, label string, start, end models.PartialDate) {
+		// an entry ending in the month or year it starts in is fine, e.g. from 2020-03 to 2020
+		if !end.IsZero() && !end.EndOfPeriod().After(start.Time) {
+			findings = append(findings, Finding{
+				Rule:       r.Name(),
+				Severity:   SeverityError,
+				EntityType: entityType,
+				EntityID:   entityID,
+				Message:    fmt.Sprintf("%s ends on %s, before it starts on %s", label, end, start),
+			})
+		}
+	}
+
+	for _, experience := range resume.WorkExperiences {
+		check(EntityWorkExperience, experience.ID, experience.Company+" / "+experience.JobTitle, experience.Start(), experience.End())
+	}
+	for _, education := range resume.Educations {
+		check(EntityEducation, education.ID, education.SchoolName, education.Start(), education.End())
+	}
+	return findings
+}
+
+// OverlappingFullTimeRule flags full-time jobs that overlap by more than Tolerance
+type OverlappingFullTimeRule struct {
+	Tolerance time.Duration
+}
+
+func (OverlappingFullTimeRule) Name() string { return "overlapping-fulltime" }
+
+func (OverlappingFullTimeRule) Description() string {
+	return "Full-time jobs should not overlap beyond a short transition"
+}
+
+func (r OverlappingFullTimeRule) Check(resume *models.Resume) []Finding {
+	now := time.Now()
+	var periods []period
+	for _, experience := range resume.WorkExperiences {
+		if experience.Type != "" && experience.Type != "fulltime" {
+			continue
+		}
+		p := newPeriod(EntityWorkExperience, experience.ID, experience.Company+" / "+experience.JobTitle, experience.Start(), experience.End(), now)
+		if p.valid() {
+			periods = append(periods, p)
+		}
+	}
+	sort.SliceStable(periods, func(i, j int) bool {
+		return periods[i].start.Before(periods[j].start)
+	})
+
+	var findings []Finding
+	for i, later := range periods {
+		for _, earlier := range periods[:i] {
+			if earlier.end.Sub(later.start) > r.Tolerance {
+				findings = append(findings, Finding{
+					Rule:       r.Name(),
+					Severity:   SeverityWarning,
+					EntityType: EntityWorkExperience,
+					EntityID:   later.entityID,
+					Message:    fmt.Sprintf("Full-time job %s overlaps with %s (work experience %d)", later.label, earlier.label, earlier.entityID),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// UnexplainedGapRule flags periods of at least MinGap not covered by any work experience or education
+type UnexplainedGapRule struct {
+	MinGap time.Duration
+}
+
+func (UnexplainedGapRule) Name() string { return "unexplained-gap" }
+
+func (r UnexplainedGapRule) Description() string {
+	return fmt.Sprintf("Gaps of %d months or more between work experiences and educations should be explained", int(r.MinGap.Hours()/24/30))
+}
+
+func (r UnexplainedGapRule) Check(resume *models.Resume) []Finding {
+	now := time.Now()
+	var periods []period
+	for _, experience := range resume.WorkExperiences {
+		periods = append(periods, newPeriod(EntityWorkExperience, experience.ID, experience.Company+" / "+experience.JobTitle, experience.Start(), experience.End(), now))
+	}
+	for _, education := range resume.Educations {
+		periods = append(periods, newPeriod(EntityEducation, education.ID, education.SchoolName, education.Start(), education.End(), now))
+	}
+
+	var findings []Finding
+	for _, g := range findGaps(periods, r.MinGap) {
+		findings = append(findings, Finding{
+			Rule:       r.Name(),
+			Severity:   SeverityWarning,
+			EntityType: g.next.entityType,
+			EntityID:   g.next.entityID,
+			Message:    fmt.Sprintf("Unexplained gap from %s to %s before %s", g.previous.to, g.next.from, g.next.label),
+		})
+	}
+	return findings
+}
+
+// DuplicateFeatureKeyRule flags feature maps that repeat a key within the same experience
+type DuplicateFeatureKeyRule struct{}
+
+func (DuplicateFeatureKeyRule) Name() string { return "duplicate-feature-key" }
+
+func (DuplicateFeatureKeyRule) Description() string {
+	return "Feature map keys should be unique within an experience"
+}
+
+func (r DuplicateFeatureKeyRule) Check(resume *models.Resume) []Finding {
+	var findings []Finding
+	check := func(featureMaps []models.FeatureMap) {
+		first := map[string]uint{}
+		for _, featureMap := range featureMaps {
+			key := strings.ToLower(strings.TrimSpace(featureMap.Key))
+			if id, ok := first[key]; ok {
+				findings = append(findings, Finding{
+					Rule:       r.Name(),
+					Severity:   SeverityWarning,
+					EntityType: EntityFeatureMap,
+					EntityID:   featureMap.ID,
+					Message:    fmt.Sprintf("Feature map key %q is already used by feature map %d", featureMap.Key, id),
+				})
+				continue
+			}
+			first[key] = featureMap.ID
+		}
+	}
+
+	for _, experience := range resume.WorkExperiences {
+		check(experience.FeatureMaps)
+	}
+	for _, education := range resume.Educations {
+		check(education.FeatureMaps)
+	}
+	for _, experience := range resume.OtherExperiences {
+		check(experience.FeatureMaps)
+	}
+	return findings
+}
+
+// EmptySectionRule flags missing resume sections and experiences without any details
+type EmptySectionRule struct{}
+
+func (EmptySectionRule) Name() string { return "empty-section" }
+
+func (EmptySectionRule) Description() string {
+	return "Resumes should have contacts, work experiences and educations, and every experience should have details"
+}
+
+func (r EmptySectionRule) Check(resume *models.Resume) []Finding {
+	var findings []Finding
+	add := func(severity, entityType string, entityID uint, message string) {
+		findings = append(findings, Finding{
+			Rule:       r.Name(),
+			Severity:   severity,
+			EntityType: entityType,
+			EntityID:   entityID,
+			Message:    message,
+		})
+	}
+
+	if len(resume.Contacts) == 0 {
+		add(SeverityWarning, EntityResume, resume.ID, "Resume has no contact information")
+	}
+	if len(resume.WorkExperiences) == 0 {
+		add(SeverityWarning, EntityResume, resume.ID, "Resume has no work experiences")
+	}
+	if len(resume.Educations) == 0 {
+		add(SeverityInfo, EntityResume, resume.ID, "Resume has no educations")
+	}
+
+	for _, experience := range resume.WorkExperiences {
+		if len(experience.FeatureMaps) == 0 {
+			add(SeverityWarning, EntityWorkExperience, experience.ID, fmt.Sprintf("Work experience %s / %s has no details", experience.Company, experience.JobTitle))
+		}
+	}
+	for _, experience := range resume.OtherExperiences {
+		if len(experience.FeatureMaps) == 0 {
+			add(SeverityWarning, EntityOtherExperience, experience.ID, fmt.Sprintf("Section %s is empty", experience.Category))
+		}
+	}
+	return findings
+}
@@ -0,0 +1,178 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func date(year int, month time.Month) time.Time {
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func datePtr(year int, month time.Month) *time.Time {
+	d := date(year, month)
+	return &d
+}
+
+func TestLintRules(t *testing.T) {
+	complete := func(r *models.Resume) *models.Resume {
+		r.ID = 1
+		r.Contacts = []models.Contact{{ID: 1, Key: "email", Value: "john@example.com"}}
+		r.Educations = append(r.Educations, models.Education{ID: 99, SchoolName: "MIT", StartDate: date(2012, 9), EndDate: datePtr(2016, 6)})
+		return r
+	}
+
+	tests := []struct {
+		name       string
+		rule       Rule
+		resume     *models.Resume
+		entityType string
+		entityIDs  []uint
+	}{
+		{
+			name: "bullet without number",
+			rule: BulletWithoutNumberRule{},
+			resume: complete(&models.Resume{WorkExperiences: []models.WorkExperience{{ID: 1, FeatureMaps: []models.FeatureMap{
+				{ID: 1, Key: "achievements", Value: "- Reduced latency by 40% for checkout\n- Improved the onboarding flow for new users"},
+				{ID: 2, Key: "skills", Value: "Go, Python, React and many more things"},
+			}}}}),
+			entityType: EntityFeatureMap,
+			entityIDs:  []uint{1},
+		},
+		{
+			name: "weak opening verb",
+			rule: WeakOpeningVerbRule{},
+			resume: complete(&models.Resume{WorkExperiences: []models.WorkExperience{{ID: 1, FeatureMaps: []models.FeatureMap{
+				{ID: 1, Key: "description", Value: "Led the migration to Kubernetes clusters"},
+				{ID: 2, Key: "description", Value: "Responsible for the billing service"},
+			}}}}),
+			entityType: EntityFeatureMap,
+			entityIDs:  []uint{2},
+		},
+		{
+			name: "long bullet",
+			rule: LongBulletRule{MaxWords: 5},
+			resume: complete(&models.Resume{OtherExperiences: []models.OtherExperience{{ID: 1, FeatureMaps: []models.FeatureMap{
+				{ID: 3, Key: "project", Value: "Built a tool that does far too many different things"},
+				{ID: 4, Key: "project", Value: "Built a small CLI"},
+			}}}}),
+			entityType: EntityFeatureMap,
+			entityIDs:  []uint{3},
+		},
+		{
+			name: "end before start",
+			rule: EndBeforeStartRule{},
+			resume: complete(&models.Resume{WorkExperiences: []models.WorkExperience{
+				{ID: 1, StartDate: date(2020, 1), EndDate: datePtr(2019, 1)},
+				{ID: 2, StartDate: date(2020, 1)},
+				// Ends within the year it starts in
+				{ID: 3, StartDate: date(2020, 3), StartDatePrecision: models.DatePrecisionMonth, EndDate: datePtr(2020, 1), EndDatePrecision: models.DatePrecisionYear},
+			}}),
+			entityType: EntityWorkExperience,
+			entityIDs:  []uint{1},
+		},
+		{
+			name: "overlapping full-time jobs",
+			rule: OverlappingFullTimeRule{Tolerance: 31 * 24 * time.Hour},
+			resume: complete(&models.Resume{WorkExperiences: []models.WorkExperience{
+				{ID: 1, Type: "fulltime", StartDate: date(2018, 1), EndDate: datePtr(2020, 6)},
+				{ID: 2, Type: "fulltime", StartDate: date(2020, 1), EndDate: datePtr(2022, 1), EndDatePrecision: models.DatePrecisionMonth},
+				// A short transition and part-time jobs are fine
+				{ID: 3, Type: "fulltime", StartDate: date(2022, 1), StartDatePrecision: models.DatePrecisionMonth, EndDate: datePtr(2023, 1)},
+				{ID: 4, Type: "parttime", StartDate: date(2019, 1), EndDate: datePtr(2019, 6)},
+			}}),
+			entityType: EntityWorkExperience,
+			entityIDs:  []uint{2},
+		},
+		{
+			name: "unexplained gap",
+			rule: UnexplainedGapRule{MinGap: 183 * 24 * time.Hour},
+			resume: complete(&models.Resume{WorkExperiences: []models.WorkExperience{
+				{ID: 1, StartDate: date(2016, 8), EndDate: datePtr(2018, 1)},
+				{ID: 2, StartDate: date(2019, 6), EndDate: datePtr(2020, 1)},
+				{ID: 3, StartDate: date(2020, 3), EndDate: datePtr(2021, 1)},
+			}}),
+			entityType: EntityWorkExperience,
+			entityIDs:  []uint{2},
+		},
+		{
+			name: "no gap between year precision dates",
+			rule: UnexplainedGapRule{MinGap: 183 * 24 * time.Hour},
+			resume: complete(&models.Resume{WorkExperiences: []models.WorkExperience{
+				{ID: 1, StartDate: date(2016, 1), StartDatePrecision: models.DatePrecisionYear, EndDate: datePtr(2018, 1), EndDatePrecision: models.DatePrecisionYear},
+				{ID: 2, StartDate: date(2019, 1), StartDatePrecision: models.DatePrecisionYear, EndDate: datePtr(2021, 1), EndDatePrecision: models.DatePrecisionYear},
+			}}),
+			entityType: EntityWorkExperience,
+			entityIDs:  []uint{},
+		},
+		{
+			name: "duplicate feature key",
+			rule: DuplicateFeatureKeyRule{},
+			resume: complete(&models.Resume{WorkExperiences: []models.WorkExperience{{ID: 1, FeatureMaps: []models.FeatureMap{
+				{ID: 1, Key: "description", Value: "a"},
+				{ID: 2, Key: "Description", Value: "b"},
+				{ID: 3, Key: "skills", Value: "c"},
+			}}}}),
+			entityType: EntityFeatureMap,
+			entityIDs:  []uint{2},
+		},
+		{
+			name: "empty other experience",
+			rule: EmptySectionRule{},
+			resume: complete(&models.Resume{
+				WorkExperiences:  []models.WorkExperience{{ID: 1, FeatureMaps: []models.FeatureMap{{ID: 1, Key: "k", Value: "v"}}}},
+				OtherExperiences: []models.OtherExperience{{ID: 7, Category: "Projects"}},
+			}),
+			entityType: EntityOtherExperience,
+			entityIDs:  []uint{7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := tt.rule.Check(tt.resume)
+			if len(findings) != len(tt.entityIDs) {
+				t.Fatalf("Expected %d findings, got %+v", len(tt.entityIDs), findings)
+			}
+			for i, finding := range findings {
+				if finding.Rule != tt.rule.Name() {
+					t.Errorf("Expected rule %s, got %s", tt.rule.Name(), finding.Rule)
+				}
+				if finding.EntityType != tt.entityType || finding.EntityID != tt.entityIDs[i] {
+					t.Errorf("Expected finding for %s %d, got %+v", tt.entityType, tt.entityIDs[i], finding)
+				}
+			}
+		})
+	}
+}
+
+func TestLint_EmptyResume(t *testing.T) {
+	findings := Lint(&models.Resume{ID: 5}, DefaultRules())
+	if len(findings) != 3 {
+		t.Fatalf("Expected missing contacts, work experiences and educations, got %+v", findings)
+	}
+	if findings[len(findings)-1].Severity != SeverityInfo {
+		t.Errorf("Expected findings sorted by severity, got %+v", findings)
+	}
+	for _, finding := range findings {
+		if finding.EntityType != EntityResume || finding.EntityID != 5 {
+			t.Errorf("Expected finding for resume 5, got %+v", finding)
+		}
+	}
+}
+
+func TestSelectRules(t *testing.T) {
+	rules, err := SelectRules(DefaultRules(), []string{"long-bullet", "empty-section"})
+	if err != nil {
+		t.Fatalf("SelectRules() error = %v", err)
+	}
+	if len(rules) != 2 || rules[0].Name() != "long-bullet" {
+		t.Errorf("Unexpected rules: %v", rules)
+	}
+
+	if _, err := SelectRules(DefaultRules(), []string{"no-such-rule"}); err == nil {
+		t.Error("Expected error for unknown rule")
+	}
+}
@@ -0,0 +1,101 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// period is a date range of a resume entry. Entries without an end date last until now.
+// The end is exclusive, the first day after the period of the entry's end date.
+type period struct {
+	entityType string
+	entityID   uint
+	label      string
+	start      time.Time
+	end        time.Time
+	// from and to are the dates as entered, to is zero for ongoing entries
+	from models.PartialDate
+	to   models.PartialDate
+}
+
+// newPeriod builds a period, treating a missing or zero end date as ongoing
+func newPeriod(entityType string, entityID uint, label string, from, to models.PartialDate, now time.Time) period {
+	p := period{entityType: entityType, entityID: entityID, label: label, start: from.Time, end: now, from: from, to: to}
+	if !to.IsZero() {
+		p.end = to.EndOfPeriod()
+	}
+	return p
+}
+
+// valid reports whether the period has a start date and doesn't end before it starts
+func (p period) valid() bool {
+	return !p.start.IsZero() && p.end.After(p.start)
+}
+
+// mergePeriods sorts valid periods by start date and merges overlapping ones.
+// The merged periods keep the entity of the first period in each group.
+func mergePeriods(periods []period) []period {
+	sorted := make([]period, 0, len(periods))
+	for _, p := range periods {
+		if p.valid() {
+			sorted = append(sorted, p)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].start.Before(sorted[j].start)
+	})
+
+	var merged []period
+	for _, p := range sorted {
+		if n := len(merged); n > 0 && !p.start.After(merged[n-1].end) {
+			if p.end.After(merged[n-1].end) {
+				merged[n-1].end, merged[n-1].to = p.end, p.to
+			}
+			continue
+		}
+		merged = append(merged, p)
+	}
+	return merged
+}
+
+// gap is the time between two merged periods, followed by the entity that ends it
+type gap struct {
+	start    time.Time
+	end      time.Time
+	previous period
+	next     period
+}
+
+// findGaps returns the gaps between the merged periods that are at least minimum long
+func findGaps(periods []period, minimum time.Duration) []gap {
+	merged := mergePeriods(periods)
+	var gaps []gap
+	for i := 1; i < len(merged); i++ {
+		if merged[i].start.Sub(merged[i-1].end) >= minimum {
+			gaps = append(gaps, gap{start: merged[i-1].end, end: merged[i].start, previous: merged[i-1], next: merged[i]})
+		}
+	}
+	return gaps
+}
+
+// totalDuration sums the merged periods so that overlapping time is only counted once
+func totalDuration(periods []period) time.Duration {
+	var total time.Duration
+	for _, p := range mergePeriods(periods) {
+		total += p.end.Sub(p.start)
+	}
+	return total
+}
+
+// years converts a duration into years, rounded to one decimal
+func years(d time.Duration) float64 {
+	return math.Round(d.Hours()/(365.25*24)*10) / 10
+}
+
+// months converts a duration into whole months
+func months(d time.Duration) int {
+	return int(math.Round(d.Hours() / (365.25 * 24 / 12)))
+}
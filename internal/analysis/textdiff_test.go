@@ -0,0 +1,64 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff_Identical(t *testing.T) {
+	if diff := UnifiedDiff("a\nb\n", "a\nb\n", "a", "b", DefaultDiffContext); diff != "" {
+		t.Errorf("Expected no diff for identical text, got:\n%s", diff)
+	}
+}
+
+func TestUnifiedDiff_ChangedLine(t *testing.T) {
+	a := "<div>\n<h1>{{.Name}}</h1>\n<p>{{.Description}}</p>\n</div>"
+	b := "<div>\n<h1 class=\"title\">{{.Name}}</h1>\n<p>{{.Description}}</p>\n</div>"
+
+	diff := UnifiedDiff(a, b, "revision 1", "revision 2", 1)
+	expected := `--- revision 1
++++ revision 2
+@@ -1,3 +1,3 @@
+ <div>
+-<h1>{{.Name}}</h1>
++<h1 class="title">{{.Name}}</h1>
+ <p>{{.Description}}</p>
+`
+	if diff != expected {
+		t.Errorf("Unexpected diff:\n%s\nexpected:\n%s", diff, expected)
+	}
+}
+
+func TestUnifiedDiff_SeparateHunks(t *testing.T) {
+	var aLines, bLines []string
+	for i := 1; i <= 20; i++ {
+		line := strings.Repeat("x", i)
+		aLines = append(aLines, line)
+		if i == 2 || i == 18 {
+			line += " changed"
+		}
+		bLines = append(bLines, line)
+	}
+	bLines = append(bLines, "appended")
+
+	diff := UnifiedDiff(strings.Join(aLines, "\n"), strings.Join(bLines, "\n"), "a", "b", DefaultDiffContext)
+	if strings.Count(diff, "@@ -") != 2 {
+		t.Fatalf("Expected 2 hunks, got:\n%s", diff)
+	}
+	if !strings.Contains(diff, "@@ -1,5 +1,5 @@") {
+		t.Errorf("Expected first hunk at the top, got:\n%s", diff)
+	}
+	if !strings.Contains(diff, "@@ -15,6 +15,7 @@") {
+		t.Errorf("Expected second hunk to include the appended line, got:\n%s", diff)
+	}
+	if !strings.Contains(diff, "+appended\n") || !strings.Contains(diff, "-xx\n+xx changed\n") {
+		t.Errorf("Expected changed and appended lines, got:\n%s", diff)
+	}
+}
+
+func TestUnifiedDiff_FromEmpty(t *testing.T) {
+	diff := UnifiedDiff("", "one\ntwo", "a", "b", DefaultDiffContext)
+	if !strings.Contains(diff, "@@ -0,0 +1,2 @@\n+one\n+two\n") {
+		t.Errorf("Unexpected diff from empty text:\n%s", diff)
+	}
+}
@@ -0,0 +1,178 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// minTimelineGap is the shortest break between periods reported as a gap
+const minTimelineGap = 30 * 24 * time.Hour
+
+// Timeline is the chronological view of a resume with computed experience statistics.
+// It's returned by get_career_timeline and available to templates through the timeline function.
+type Timeline struct {
+	Entries []TimelineEntry `json:"entries"`
+	// TotalYears is the professional experience with overlapping work experiences counted once
+	TotalYears     float64            `json:"total_years"`
+	YearsByType    map[string]float64 `json:"years_by_type"`
+	EducationYears float64            `json:"education_years"`
+	Gaps           []TimelineGap      `json:"gaps"`
+	Skills         []SkillYears       `json:"skills"`
+}
+
+// TimelineEntry is a work experience or education on the timeline
+type TimelineEntry struct {
+	EntityType string  `json:"entity_type"`
+	EntityID   uint    `json:"entity_id"`
+	Label      string  `json:"label"`
+	Type       string  `json:"type"`
+	StartDate  string  `json:"start_date"`
+	EndDate    string  `json:"end_date"`
+	Ongoing    bool    `json:"ongoing"`
+	Years      float64 `json:"years"`
+}
+
+// TimelineGap is a break of at least a month not covered by any work experience or education
+type TimelineGap struct {
+	// StartDate is the end date of the entry before the gap, EndDate the start date of the entry after it
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Months    int    `json:"months"`
+	// Before is the entry that ends the gap
+	Before string `json:"before"`
+}
+
+// SkillYears is the time a skill was used, based on the work experiences listing it
+type SkillYears struct {
+	Skill string  `json:"skill"`
+	Years float64 `json:"years"`
+}
+
+// BuildTimeline computes the timeline of the resume's work experiences and educations.
+// Entries without an end date are treated as ongoing until now. Entries without a start
+// date or ending before they start are listed but left out of all statistics.
+func BuildTimeline(resume *models.Resume, now time.Time) Timeline {
+	timeline := Timeline{
+		Entries:     []TimelineEntry{},
+		YearsByType: map[string]float64{},
+		Gaps:        []TimelineGap{},
+		Skills:      []SkillYears{},
+	}
+
+	var work, education, all []period
+	workByType := map[string][]period{}
+	skillPeriods := map[string][]period{}
+	var skillNames []string
+
+	for _, experience := range resume.WorkExperiences {
+		label := experience.Company + " / " + experience.JobTitle
+		p := newPeriod(EntityWorkExperience, experience.ID, label, experience.Start(), experience.End(), now)
+		employmentType := experience.Type
+		if employmentType == "" {
+			employmentType = "fulltime"
+		}
+		timeline.Entries = append(timeline.Entries, newTimelineEntry(p, employmentType))
+
+		work = append(work, p)
+		workByType[employmentType] = append(workByType[employmentType], p)
+		for _, skill := range experienceSkills(experience.FeatureMaps) {
+			key := strings.ToLower(skill)
+			if _, ok := skillPeriods[key]; !ok {
+				skillNames = append(skillNames, skill)
+			}
+			skillPeriods[key] = append(skillPeriods[key], p)
+		}
+	}
+
+	for _, e := range resume.Educations {
+		p := newPeriod(EntityEducation, e.ID, e.SchoolName, e.Start(), e.End(), now)
+		employmentType := e.Type
+		if employmentType == "" {
+			employmentType = "fulltime"
+		}
+		timeline.Entries = append(timeline.Entries, newTimelineEntry(p, employmentType))
+		education = append(education, p)
+	}
+
+	sort.SliceStable(timeline.Entries, func(i, j int) bool {
+		return timeline.Entries[i].StartDate < timeline.Entries[j].StartDate
+	})
+
+	timeline.TotalYears = years(totalDuration(work))
+	timeline.EducationYears = years(totalDuration(education))
+	for employmentType, periods := range workByType {
+		timeline.YearsByType[employmentType] = years(totalDuration(periods))
+	}
+
+	all = append(append(all, work...), education...)
+	for _, g := range findGaps(all, minTimelineGap) {
+		timeline.Gaps = append(timeline.Gaps, TimelineGap{
+			StartDate: g.previous.to.String(),
+			EndDate:   g.next.from.String(),
+			Months:    months(g.end.Sub(g.start)),
+			Before:    g.next.label,
+		})
+	}
+
+	for _, skill := range skillNames {
+		timeline.Skills = append(timeline.Skills, SkillYears{
+			Skill: skill,
+			Years: years(totalDuration(skillPeriods[strings.ToLower(skill)])),
+		})
+	}
+	sort.SliceStable(timeline.Skills, func(i, j int) bool {
+		return timeline.Skills[i].Years > timeline.Skills[j].Years
+	})
+
+	return timeline
+}
+
+func newTimelineEntry(p period, employmentType string) TimelineEntry {
+	entry := TimelineEntry{
+		EntityType: p.entityType,
+		EntityID:   p.entityID,
+		Label:      p.label,
+		Type:       employmentType,
+		StartDate:  p.from.String(),
+		EndDate:    p.to.String(),
+		Ongoing:    p.to.IsZero(),
+	}
+	if p.valid() {
+		entry.Years = years(p.end.Sub(p.start))
+	}
+	return entry
+}
+
+// experienceSkills returns the skills listed in feature maps tagged as skills,
+// either through their category or their key. Values are split on commas,
+// semicolons and new lines.
+func experienceSkills(featureMaps []models.FeatureMap) []string {
+	var skills []string
+	seen := map[string]bool{}
+	for _, featureMap := range featureMaps {
+		if !isSkillTag(featureMap.Category) && !isSkillTag(featureMap.Key) {
+			continue
+		}
+		for _, skill := range strings.FieldsFunc(featureMap.Value, func(r rune) bool {
+			return r == ',' || r == ';' || r == '\n' || r == '•'
+		}) {
+			skill = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(skill), "-*"))
+			if skill != "" && !seen[strings.ToLower(skill)] {
+				seen[strings.ToLower(skill)] = true
+				skills = append(skills, skill)
+			}
+		}
+	}
+	return skills
+}
+
+func isSkillTag(tag string) bool {
+	switch strings.ToLower(strings.TrimSpace(tag)) {
+	case "skill", "skills", "technologies", "tech_stack":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	now := date(2024, 1)
+	resume := &models.Resume{
+		WorkExperiences: []models.WorkExperience{
+			{
+				ID: 1, Company: "A", JobTitle: "Engineer", Type: "fulltime",
+				StartDate: date(2016, 1), EndDate: datePtr(2020, 1),
+				FeatureMaps: []models.FeatureMap{
+					{Key: "skills", Value: "Go, Python"},
+					{Key: "description", Value: "Built things with Rust"},
+				},
+			},
+			{
+				// Overlaps the first job by a year
+				ID: 2, Company: "B", JobTitle: "Consultant", Type: "parttime",
+				StartDate: date(2019, 1), EndDate: datePtr(2021, 1),
+				FeatureMaps: []models.FeatureMap{
+					{Key: "tools", Category: "Skills", Value: "go\nKubernetes"},
+				},
+			},
+			{
+				// Ongoing after a one year gap
+				ID: 3, Company: "C", JobTitle: "Lead", StartDate: date(2022, 1),
+			},
+		},
+		Educations: []models.Education{
+			{ID: 1, SchoolName: "MIT", StartDate: date(2012, 1), EndDate: datePtr(2016, 1)},
+		},
+	}
+
+	timeline := BuildTimeline(resume, now)
+
+	if timeline.TotalYears != 7 {
+		t.Errorf("Expected 7 total years with overlaps merged, got %v", timeline.TotalYears)
+	}
+	if timeline.YearsByType["fulltime"] != 6 || timeline.YearsByType["parttime"] != 2 {
+		t.Errorf("Unexpected years by type: %v", timeline.YearsByType)
+	}
+	if timeline.EducationYears != 4 {
+		t.Errorf("Expected 4 education years, got %v", timeline.EducationYears)
+	}
+
+	if len(timeline.Gaps) != 1 || timeline.Gaps[0].Months != 12 || timeline.Gaps[0].Before != "C / Lead" {
+		t.Errorf("Expected one 12 month gap before C, got %+v", timeline.Gaps)
+	}
+
+	skills := map[string]float64{}
+	for _, s := range timeline.Skills {
+		skills[s.Skill] = s.Years
+	}
+	if len(skills) != 3 || skills["Go"] != 5 || skills["Python"] != 4 || skills["Kubernetes"] != 2 {
+		t.Errorf("Unexpected skill years: %+v", timeline.Skills)
+	}
+
+	if len(timeline.Entries) != 4 || timeline.Entries[0].Label != "MIT" {
+		t.Fatalf("Expected entries in chronological order, got %+v", timeline.Entries)
+	}
+	last := timeline.Entries[3]
+	if !last.Ongoing || last.EndDate != "" || last.Years != 2 {
+		t.Errorf("Expected ongoing last entry of 2 years, got %+v", last)
+	}
+}
+
+func TestBuildTimeline_InvalidDates(t *testing.T) {
+	resume := &models.Resume{
+		WorkExperiences: []models.WorkExperience{
+			{ID: 1, StartDate: date(2020, 1), EndDate: datePtr(2019, 1)},
+			{ID: 2},
+		},
+	}
+
+	timeline := BuildTimeline(resume, time.Now())
+	if timeline.TotalYears != 0 || len(timeline.Gaps) != 0 {
+		t.Errorf("Expected invalid periods to be ignored, got %+v", timeline)
+	}
+	if len(timeline.Entries) != 2 {
+		t.Errorf("Expected invalid entries to be listed, got %+v", timeline.Entries)
+	}
+}
+
+func TestBuildTimeline_DatePrecision(t *testing.T) {
+	year := func(experience models.WorkExperience) models.WorkExperience {
+		experience.StartDatePrecision, experience.EndDatePrecision = models.DatePrecisionYear, models.DatePrecisionYear
+		return experience
+	}
+	month := func(experience models.WorkExperience) models.WorkExperience {
+		experience.StartDatePrecision, experience.EndDatePrecision = models.DatePrecisionMonth, models.DatePrecisionMonth
+		return experience
+	}
+	resume := &models.Resume{
+		WorkExperiences: []models.WorkExperience{
+			// End dates last until the end of their year or month
+			year(models.WorkExperience{ID: 1, Company: "A", StartDate: date(2018, 1), EndDate: datePtr(2018, 1)}),
+			year(models.WorkExperience{ID: 2, Company: "B", StartDate: date(2019, 1), EndDate: datePtr(2021, 1)}),
+			month(models.WorkExperience{ID: 3, Company: "C", StartDate: date(2022, 1), EndDate: datePtr(2022, 3)}),
+			month(models.WorkExperience{ID: 4, Company: "D", StartDate: date(2022, 6), EndDate: datePtr(2022, 12)}),
+		},
+	}
+
+	timeline := BuildTimeline(resume, time.Now())
+
+	years := map[uint]float64{}
+	for _, entry := range timeline.Entries {
+		years[entry.EntityID] = entry.Years
+	}
+	if years[1] != 1 || years[2] != 3 || years[3] != 0.2 || years[4] != 0.6 {
+		t.Errorf("Unexpected entry years: %+v", timeline.Entries)
+	}
+	if first := timeline.Entries[0]; first.StartDate != "2018" || first.EndDate != "2018" {
+		t.Errorf("Expected dates printed with their precision, got %+v", first)
+	}
+	if timeline.TotalYears != 4.8 {
+		t.Errorf("Expected 4.8 total years, got %v", timeline.TotalYears)
+	}
+
+	// 2018 to 2019 is consecutive, April and May 2022 are a gap
+	if len(timeline.Gaps) != 1 || timeline.Gaps[0] != (TimelineGap{StartDate: "2022-03", EndDate: "2022-06", Months: 2, Before: "D / "}) {
+		t.Errorf("Expected one 2 month gap before D, got %+v", timeline.Gaps)
+	}
+}
@@ -1,18 +1,26 @@
 package api
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/gofiber/adaptor/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/docx"
+	"github.com/rxtech-lab/resume-mcp/internal/events"
+	"github.com/rxtech-lab/resume-mcp/internal/latex"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
 	"github.com/rxtech-lab/resume-mcp/internal/service"
 	types "github.com/rxtech-lab/resume-mcp/internal/types"
 
@@ -26,9 +34,13 @@ type APIServer struct {
 	db               *database.Database
 	templateService  *service.TemplateService
 	streamableServer *server.StreamableHTTPServer
+	broker           *events.Broker
 }
 
-func NewAPIServer(db *database.Database, templateService *service.TemplateService) *APIServer {
+// previewKeepAliveInterval is how often an idle preview event stream sends a comment to detect closed connections
+var previewKeepAliveInterval = 15 * time.Second
+
+func NewAPIServer(db *database.Database, templateService *service.TemplateService, broker *events.Broker) *APIServer {
 	app := fiber.New(fiber.Config{
 		DisableStartupMessage: true,
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
@@ -48,6 +60,7 @@ func NewAPIServer(db *database.Database, templateService *service.TemplateServic
 		app:             app,
 		db:              db,
 		templateService: templateService,
+		broker:          broker,
 	}
 
 	return server
@@ -74,7 +87,10 @@ func (s *APIServer) SetupRoutes() {
 	// add health check
 	s.app.Get("/health", s.handleHealth)
 	s.app.Get("/resume/preview/:sessionId", s.handlePreview)
+	s.app.Get("/resume/preview/:sessionId/events", s.handlePreviewEvents)
 	s.app.Get("/resume/download/:sessionId", s.handleDownload)
+	s.app.Get("/resume/export/:sessionId", s.handleExport)
+	s.app.Get("/resume/packet/:packetId", s.handlePacketDownload)
 	if s.streamableServer != nil {
 		s.app.All("/mcp", s.createAuthenticatedMCPHandler(s.streamableServer))
 	}
@@ -126,8 +142,37 @@ func (s *APIServer) handlePreview(c *fiber.Ctx) error {
 
 	// Generate download URL for the button
 	downloadURL := fmt.Sprintf("/resume/download/%s", sessionID)
+	// The app bar listens on this stream and reloads the page when the resume changes
+	eventsURL := fmt.Sprintf("/resume/preview/%s/events", sessionID)
 
-	fullHTML, err := s.templateService.GeneratePreviewWithOptions(session.Template, session.CSS, session.Resume, true, downloadURL)
+	data, err := s.sessionData(session)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("Cover letter not found: %v", err)
+		log.SetOutput(io.Discard)
+		return c.Status(404).JSON(fiber.Map{
+			"error": "Cover letter not found",
+		})
+	}
+
+	// LaTeX sessions show their source, the browser can't render it
+	if session.Format == models.TemplateFormatLaTeX {
+		source, err := s.userTemplateService(session.UserID).GenerateLaTeX(s.sessionTemplate(session), data)
+		if err != nil {
+			log.SetOutput(os.Stderr)
+			log.SetFlags(0)
+			log.Printf("LaTeX generation failed: %v", err)
+			log.SetOutput(io.Discard)
+			return c.Status(500).JSON(fiber.Map{
+				"error": "Failed to generate preview",
+			})
+		}
+		c.Set("Content-Type", latexContentType)
+		return c.SendString(source)
+	}
+
+	fullHTML, err := s.userTemplateService(session.UserID).GeneratePreviewWithOptions(s.sessionTemplate(session), session.CSS, data, true, downloadURL, eventsURL)
 	if err != nil {
 		log.SetOutput(os.Stderr)
 		log.SetFlags(0)
@@ -143,6 +188,67 @@ func (s *APIServer) handlePreview(c *fiber.Ctx) error {
 	return c.SendString(fullHTML)
 }
 
+func (s *APIServer) handlePreviewEvents(c *fiber.Ctx) error {
+	sessionID := c.Params("sessionId")
+
+	session, err := s.db.GetPreviewSession(sessionID, nil)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("Preview session not found: %v", err)
+		log.SetOutput(io.Discard)
+		return c.Status(404).JSON(fiber.Map{
+			"error": "Preview session not found",
+		})
+	}
+
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+
+	changes, unsubscribe := s.broker.Subscribe()
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+
+		keepAlive := time.NewTicker(previewKeepAliveInterval)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case event, ok := <-changes:
+				if !ok {
+					return
+				}
+				if !affectsSession(event, session) {
+					continue
+				}
+				fmt.Fprintf(w, "event: change\ndata: {\"resume_id\": %d}\n\n", event.ResumeID)
+			case <-keepAlive.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+			}
+			// A failed flush means the browser went away
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// affectsSession reports whether the change has to reload the preview of the session
+func affectsSession(event events.ChangeEvent, session *models.PreviewSession) bool {
+	// Style changes of other sessions don't affect this page
+	if event.SessionID != "" && event.SessionID != session.ID {
+		return false
+	}
+	if event.ResumeID == session.ResumeID {
+		return true
+	}
+	// Changes of user templates aren't tied to a resume
+	return event.TemplateID != 0 && session.TemplateID != nil && event.TemplateID == *session.TemplateID
+}
+
 func (s *APIServer) handleDownload(c *fiber.Ctx) error {
 	sessionID := c.Params("sessionId")
 
@@ -157,7 +263,22 @@ func (s *APIServer) handleDownload(c *fiber.Ctx) error {
 		})
 	}
 
-	pdfBuffer, err := s.templateService.GeneratePDF(session.Template, session.CSS, session.Resume)
+	data, err := s.sessionData(session)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("Cover letter not found: %v", err)
+		log.SetOutput(io.Discard)
+		return c.Status(404).JSON(fiber.Map{
+			"error": "Cover letter not found",
+		})
+	}
+
+	if session.Format == models.TemplateFormatLaTeX {
+		return s.sendCompiledLaTeX(c, session, data)
+	}
+
+	pdfBuffer, err := s.userTemplateService(session.UserID).GeneratePDF(s.sessionTemplate(session), session.CSS, data)
 	if err != nil {
 		log.SetOutput(os.Stderr)
 		log.SetFlags(0)
@@ -170,10 +291,231 @@ func (s *APIServer) handleDownload(c *fiber.Ctx) error {
 
 	// Set headers for PDF download
 	c.Set("Content-Type", "application/pdf")
-	c.Set("Content-Disposition", "attachment; filename=\"resume.pdf\"")
+	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", service.PDFFileName(data)))
+	return c.Send(pdfBuffer)
+}
+
+// handleExport returns the session's resume or cover letter in a text format, as DOCX or, for sessions of
+// LaTeX templates, as LaTeX source, given by the format query parameter
+func (s *APIServer) handleExport(c *fiber.Ctx) error {
+	sessionID := c.Params("sessionId")
+
+	formatName := c.Query("format", service.FormatMarkdown)
+	format, err := service.LookupTextFormat(formatName)
+	if err != nil && formatName != docx.Format && formatName != models.TemplateFormatLaTeX {
+		return c.Status(400).JSON(fiber.Map{
+			"error": fmt.Sprintf("unsupported format %q, must be one of: %s", formatName, strings.Join(append(service.TextFormatNames(), docx.Format, models.TemplateFormatLaTeX), ", ")),
+		})
+	}
+
+	session, err := s.db.GetPreviewSession(sessionID, nil)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("Preview session not found: %v", err)
+		log.SetOutput(io.Discard)
+		return c.Status(404).JSON(fiber.Map{
+			"error": "Preview session not found",
+		})
+	}
+
+	data, err := s.sessionData(session)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("Cover letter not found: %v", err)
+		log.SetOutput(io.Discard)
+		return c.Status(404).JSON(fiber.Map{
+			"error": "Cover letter not found",
+		})
+	}
+
+	if formatName == models.TemplateFormatLaTeX {
+		if session.Format != models.TemplateFormatLaTeX {
+			return c.Status(400).JSON(fiber.Map{
+				"error": "The session's template isn't a LaTeX template",
+			})
+		}
+		source, err := s.userTemplateService(session.UserID).GenerateLaTeX(s.sessionTemplate(session), data)
+		if err != nil {
+			log.SetOutput(os.Stderr)
+			log.SetFlags(0)
+			log.Printf("LaTeX export failed: %v", err)
+			log.SetOutput(io.Discard)
+			return c.Status(500).JSON(fiber.Map{
+				"error": "Failed to export",
+			})
+		}
+
+		c.Set("Content-Type", latexContentType)
+		c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", service.LaTeXFileName(data)))
+		return c.SendString(source)
+	}
+
+	if formatName == docx.Format {
+		styles, err := docx.ParseStyles([]byte(session.DocxStyles))
+		if err != nil {
+			styles = docx.DefaultStyles()
+		}
+		file, err := docx.Write(data, styles)
+		if err != nil {
+			log.SetOutput(os.Stderr)
+			log.SetFlags(0)
+			log.Printf("DOCX export failed: %v", err)
+			log.SetOutput(io.Discard)
+			return c.Status(500).JSON(fiber.Map{
+				"error": "Failed to export",
+			})
+		}
+
+		c.Set("Content-Type", docx.ContentType)
+		c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", docx.FileName(data)))
+		return c.Send(file)
+	}
+
+	text, err := s.userTemplateService(session.UserID).ExportText(format.Name, data)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("Export failed: %v", err)
+		log.SetOutput(io.Discard)
+		return c.Status(500).JSON(fiber.Map{
+			"error": "Failed to export",
+		})
+	}
+
+	c.Set("Content-Type", format.ContentType)
+	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", service.ExportFileName(data, format)))
+	return c.SendString(text)
+}
+
+// latexContentType is the content type of LaTeX source
+const latexContentType = "application/x-tex; charset=utf-8"
+
+// sendCompiledLaTeX compiles the LaTeX source of a session with the installed LaTeX compiler and sends the PDF
+func (s *APIServer) sendCompiledLaTeX(c *fiber.Ctx, session *models.PreviewSession, data any) error {
+	source, err := s.userTemplateService(session.UserID).GenerateLaTeX(s.sessionTemplate(session), data)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("LaTeX generation failed: %v", err)
+		log.SetOutput(io.Discard)
+		return c.Status(500).JSON(fiber.Map{
+			"error": "Failed to generate PDF",
+		})
+	}
+
+	pdf, err := latex.Compile(c.Context(), source, service.DefaultPDFTimeout)
+	if errors.Is(err, latex.ErrNoCompiler) {
+		return c.Status(503).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("LaTeX compilation failed: %v", err)
+		log.SetOutput(io.Discard)
+		return c.Status(500).JSON(fiber.Map{
+			"error": "Failed to compile LaTeX",
+		})
+	}
+
+	c.Set("Content-Type", "application/pdf")
+	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", service.PDFFileName(data)))
+	return c.Send(pdf)
+}
+
+func (s *APIServer) handlePacketDownload(c *fiber.Ctx) error {
+	packetID := c.Params("packetId")
+
+	packet, err := s.db.GetApplicationPacket(packetID, nil)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("Application packet not found: %v", err)
+		log.SetOutput(io.Discard)
+		return c.Status(404).JSON(fiber.Map{
+			"error": "Application packet not found",
+		})
+	}
+
+	documents := make([]service.PacketDocument, 0, len(packet.Documents))
+	for i := range packet.Documents {
+		document := &packet.Documents[i]
+		data, err := s.db.PacketDocumentData(document, nil)
+		if err != nil {
+			log.SetOutput(os.Stderr)
+			log.SetFlags(0)
+			log.Printf("Packet document %q not found: %v", document.Title, err)
+			log.SetOutput(io.Discard)
+			return c.Status(404).JSON(fiber.Map{
+				"error": fmt.Sprintf("Document %q of the application packet not found", document.Title),
+			})
+		}
+		documents = append(documents, service.PacketDocument{
+			Title:    document.Title,
+			Template: document.Template,
+			CSS:      document.CSS,
+			Data:     data,
+		})
+	}
+
+	pdfBuffer, err := s.userTemplateService(packet.UserID).GeneratePacketPDF(c.Context(), documents, service.DefaultPDFTimeout)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("Packet generation failed: %v", err)
+		log.SetOutput(io.Discard)
+		return c.Status(500).JSON(fiber.Map{
+			"error": "Failed to generate application packet",
+		})
+	}
+
+	c.Set("Content-Type", "application/pdf")
+	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", service.PacketFileName))
 	return c.Send(pdfBuffer)
 }
 
+// sessionData returns what the session's template renders, its cover letter or its resume
+func (s *APIServer) sessionData(session *models.PreviewSession) (any, error) {
+	if session.CoverLetterID == nil {
+		return session.Resume, nil
+	}
+	letter, err := s.db.GetCoverLetterByID(*session.CoverLetterID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return *letter, nil
+}
+
+// userTemplateService returns the template service resolving the partials of the user. Without them,
+// templates that include a partial fail to render with an error naming it.
+func (s *APIServer) userTemplateService(userID string) *service.TemplateService {
+	partials, err := s.db.PartialSources(&userID)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("Failed to load partials: %v", err)
+		log.SetOutput(io.Discard)
+		return s.templateService
+	}
+	return s.templateService.WithPartials(partials)
+}
+
+// sessionTemplate returns the template data the session renders. Sessions that aren't pinned to a revision
+// follow the latest data of their template, falling back to the data saved with the session once it is deleted.
+func (s *APIServer) sessionTemplate(session *models.PreviewSession) string {
+	if session.TemplateID == nil || session.TemplateRevision != nil {
+		return session.Template
+	}
+	template, err := s.db.GetTemplateByID(*session.TemplateID, &session.UserID)
+	if err != nil {
+		return session.Template
+	}
+	return template.TemplateData
+}
+
 func (s *APIServer) handleHealth(c *fiber.Ctx) error {
 	return c.JSON(fiber.Map{
 		"status":  "ok",
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"bufio"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/events"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+func TestAffectsSession(t *testing.T) {
+	templateID := uint(7)
+	session := &models.PreviewSession{ID: "session", ResumeID: 1, TemplateID: &templateID}
+
+	tests := []struct {
+		name     string
+		event    events.ChangeEvent
+		expected bool
+	}{
+		{"resume change", events.ChangeEvent{ResumeID: 1}, true},
+		{"other resume", events.ChangeEvent{ResumeID: 2}, false},
+		{"style of this session", events.ChangeEvent{ResumeID: 1, SessionID: "session"}, true},
+		{"style of another session", events.ChangeEvent{ResumeID: 1, SessionID: "other"}, false},
+		{"user template of the session", events.ChangeEvent{TemplateID: 7}, true},
+		{"other user template", events.ChangeEvent{TemplateID: 8}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if affects := affectsSession(test.event, session); affects != test.expected {
+				t.Errorf("affectsSession() = %v, expected %v", affects, test.expected)
+			}
+		})
+	}
+
+	if affectsSession(events.ChangeEvent{TemplateID: 7}, &models.PreviewSession{ResumeID: 1}) {
+		t.Error("Expected template changes not to reload sessions without a template")
+	}
+}
+
+func TestHandlePreviewEvents_UserTemplateChange(t *testing.T) {
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		t.Fatalf("Failed to create test database: %v", err)
+	}
+	defer db.Close()
+
+	userID := "test-user"
+	resume := &models.Resume{Name: "Test Resume"}
+	if err := db.CreateResume(resume, &userID); err != nil {
+		t.Fatalf("Failed to create resume: %v", err)
+	}
+	template := &models.Template{Name: "Shared", TemplateData: "<h1>{{.Name}}</h1>"}
+	if err := db.CreateTemplate(template, &userID); err != nil {
+		t.Fatalf("Failed to create user template: %v", err)
+	}
+	session := &models.PreviewSession{ID: "session", ResumeID: resume.ID, Template: template.TemplateData, TemplateID: &template.ID, UserID: userID}
+	if err := db.DB.Create(session).Error; err != nil {
+		t.Fatalf("Failed to create preview session: %v", err)
+	}
+
+	// Closed streams are only noticed when writing, which the server waits for on shutdown
+	keepAlive := previewKeepAliveInterval
+	previewKeepAliveInterval = 10 * time.Millisecond
+	defer func() { previewKeepAliveInterval = keepAlive }()
+
+	broker := events.NewBroker()
+	server := NewAPIServer(db, service.NewTemplateService(), broker)
+	server.SetupRoutes()
+	port, err := server.Start("0")
+	if err != nil {
+		t.Fatalf("Failed to start server: %v", err)
+	}
+	defer server.Shutdown()
+
+	received := make(chan string, 1)
+	go func() {
+		response, err := http.Get("http://localhost:" + port + "/resume/preview/session/events")
+		if err != nil {
+			received <- err.Error()
+			return
+		}
+		defer response.Body.Close()
+		scanner := bufio.NewScanner(response.Body)
+		for scanner.Scan() {
+			if strings.HasPrefix(scanner.Text(), "event: ") {
+				received <- scanner.Text()
+				return
+			}
+		}
+	}()
+
+	// The event an update_template call on the user template publishes. It is repeated
+	// until the page is connected, events published before subscribing are missed.
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case line := <-received:
+			if line != "event: change" {
+				t.Errorf("Expected a change event, got %q", line)
+			}
+			return
+		case <-ticker.C:
+			broker.Publish(events.ChangeEvent{UserID: userID, TemplateID: template.ID})
+		case <-timeout:
+			t.Fatal("Expected the preview to reload after its user template changed")
+		}
+	}
+}
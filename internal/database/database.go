@@ -54,7 +54,7 @@ func NewPostgresDatabase(postgresURL string) (*Database, error) {
 }
 
 func (d *Database) migrate() error {
-	return d.DB.AutoMigrate(
+	err := d.DB.AutoMigrate(
 		&models.Resume{},
 		&models.Contact{},
 		&models.WorkExperience{},
@@ -63,7 +63,69 @@ func (d *Database) migrate() error {
 		&models.FeatureMap{},
 		&models.PreviewSession{},
 		&models.Template{},
+		&models.TemplateRevision{},
+		&models.Partial{},
+		&models.CoverLetter{},
+		&models.CoverLetterSection{},
+		&models.ApplicationPacket{},
+		&models.PacketDocument{},
 	)
+	if err != nil {
+		return err
+	}
+	if err := d.relaxTemplateResumeID(); err != nil {
+		return err
+	}
+	return d.backfillTemplateRevisions()
+}
+
+// backfillTemplateRevisions records the data of templates created before revisions were kept as their revision 1
+func (d *Database) backfillTemplateRevisions() error {
+	var templates []models.Template
+	err := d.DB.Where("NOT EXISTS (SELECT 1 FROM template_revisions WHERE template_revisions.template_id = templates.id)").
+		Find(&templates).Error
+	if err != nil || len(templates) == 0 {
+		return err
+	}
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		for _, template := range templates {
+			err := tx.Create(&models.TemplateRevision{
+				TemplateID:   template.ID,
+				Revision:     1,
+				TemplateData: template.TemplateData,
+				Note:         TemplateRevisionNoteInitial,
+				UserID:       template.UserID,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// relaxTemplateResumeID drops the NOT NULL constraint of templates.resume_id from databases created
+// before user templates, AutoMigrate adds new columns but doesn't change existing ones
+func (d *Database) relaxTemplateResumeID() error {
+	columns, err := d.DB.Migrator().ColumnTypes(&models.Template{})
+	if err != nil {
+		return err
+	}
+	for _, column := range columns {
+		if column.Name() != "resume_id" {
+			continue
+		}
+		if nullable, ok := column.Nullable(); ok && !nullable {
+			if err := d.DB.Migrator().AlterColumn(&models.Template{}, "ResumeID"); err != nil {
+				return err
+			}
+		}
+	}
+	// SQLite alters columns by rebuilding the table, which drops its indexes
+	if !d.DB.Migrator().HasIndex(&models.Template{}, "ResumeID") {
+		return d.DB.Migrator().CreateIndex(&models.Template{}, "ResumeID")
+	}
+	return nil
 }
 
 func (d *Database) CreateResume(resume *models.Resume, userID *string) error {
@@ -73,6 +135,36 @@ func (d *Database) CreateResume(resume *models.Resume, userID *string) error {
 	return d.DB.Create(resume).Error
 }
 
+// ImportResume creates a resume together with its contacts, experiences and feature maps,
+// all owned by the user
+func (d *Database) ImportResume(resume *models.Resume, userID *string) error {
+	if userID != nil {
+		resume.UserID = *userID
+		for i := range resume.Contacts {
+			resume.Contacts[i].UserID = *userID
+		}
+		for i := range resume.WorkExperiences {
+			resume.WorkExperiences[i].UserID = *userID
+			setFeatureMapsUser(resume.WorkExperiences[i].FeatureMaps, *userID)
+		}
+		for i := range resume.Educations {
+			resume.Educations[i].UserID = *userID
+			setFeatureMapsUser(resume.Educations[i].FeatureMaps, *userID)
+		}
+		for i := range resume.OtherExperiences {
+			resume.OtherExperiences[i].UserID = *userID
+			setFeatureMapsUser(resume.OtherExperiences[i].FeatureMaps, *userID)
+		}
+	}
+	return d.DB.Create(resume).Error
+}
+
+func setFeatureMapsUser(featureMaps []models.FeatureMap, userID string) {
+	for i := range featureMaps {
+		featureMaps[i].UserID = userID
+	}
+}
+
 func (d *Database) GetResumeByName(name string, userID *string) (*models.Resume, error) {
 	var resume models.Resume
 	query := d.DB.Preload("Contacts").
@@ -201,6 +293,25 @@ func (d *Database) DeleteFeatureMap(id uint, userID *string) error {
 	return query.Delete(&models.FeatureMap{}, id).Error
 }
 
+// ResumeIDsForExperience returns the resumes owning an experience with the given ID.
+// Feature maps reference work experiences, educations and other experiences by ID
+// alone, so more than one resume can match.
+func (d *Database) ResumeIDsForExperience(experienceID uint, userID *string) ([]uint, error) {
+	var resumeIDs []uint
+	for _, model := range []any{&models.WorkExperience{}, &models.Education{}, &models.OtherExperience{}} {
+		var ids []uint
+		query := d.DB.Model(model).Where("id = ?", experienceID)
+		if userID != nil {
+			query = query.Where("user_id = ?", *userID)
+		}
+		if err := query.Pluck("resume_id", &ids).Error; err != nil {
+			return nil, err
+		}
+		resumeIDs = append(resumeIDs, ids...)
+	}
+	return resumeIDs, nil
+}
+
 func (d *Database) GeneratePreview(resumeID uint, template string, css string, userID *string) (string, error) {
 	sessionID := uuid.New().String()
 	session := &models.PreviewSession{
@@ -245,6 +356,21 @@ func (d *Database) GetPreviewSession(sessionID string, userID *string) (*models.
 	return &session, nil
 }
 
+// ListPreviewSessions returns the preview sessions with the name of their resume, newest first
+func (d *Database) ListPreviewSessions(userID *string) ([]models.PreviewSession, error) {
+	var sessions []models.PreviewSession
+	query := d.DB.Select("id, resume_id, created_at, user_id").
+		Preload("Resume", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, name")
+		}).
+		Order("created_at desc")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.Find(&sessions).Error
+	return sessions, err
+}
+
 func (d *Database) UpdatePreviewSessionCSS(sessionID string, css string, userID *string) error {
 	query := d.DB.Model(&models.PreviewSession{}).
 		Where("id = ?", sessionID)
@@ -254,12 +380,31 @@ func (d *Database) UpdatePreviewSessionCSS(sessionID string, css string, userID
 	return query.Update("css", css).Error
 }
 
+// Template revision notes recorded by the database
+const (
+	TemplateRevisionNoteCreated = "Created"
+	TemplateRevisionNoteInitial = "Initial version"
+)
+
 // Template CRUD operations
+
+// CreateTemplate creates the template and records its data as revision 1
 func (d *Database) CreateTemplate(template *models.Template, userID *string) error {
 	if userID != nil {
 		template.UserID = *userID
 	}
-	return d.DB.Create(template).Error
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(template).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.TemplateRevision{
+			TemplateID:   template.ID,
+			Revision:     1,
+			TemplateData: template.TemplateData,
+			Note:         TemplateRevisionNoteCreated,
+			UserID:       template.UserID,
+		}).Error
+	})
 }
 
 func (d *Database) GetTemplateByID(id uint, userID *string) (*models.Template, error) {
@@ -285,19 +430,340 @@ func (d *Database) ListTemplatesByResumeID(resumeID uint, userID *string) ([]mod
 	return templates, err
 }
 
+// ListUserTemplates returns the templates that belong to the user instead of a single resume
+func (d *Database) ListUserTemplates(userID *string) ([]models.Template, error) {
+	var templates []models.Template
+	query := d.DB.Where("resume_id IS NULL")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.Find(&templates).Error
+	return templates, err
+}
+
+// ListTemplatesWithData returns the user's resume and user templates including their template data
+func (d *Database) ListTemplatesWithData(userID *string) ([]models.Template, error) {
+	var templates []models.Template
+	query := d.DB.Order("id")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.Find(&templates).Error
+	return templates, err
+}
+
+func (d *Database) ListTemplates(userID *string) ([]models.Template, error) {
+	var templates []models.Template
+	query := d.DB.Select("id, resume_id, name, description, type, created_at, updated_at, user_id")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.Find(&templates).Error
+	return templates, err
+}
+
 func (d *Database) UpdateTemplate(template *models.Template, userID *string) error {
+	return d.UpdateTemplateWithNote(template, "", userID)
+}
+
+// UpdateTemplateWithNote saves the template and records a new revision with the note when its data changed.
+// Templates without revisions, which migrate backfills, get their stored data recorded as revision 1 first.
+func (d *Database) UpdateTemplateWithNote(template *models.Template, note string, userID *string) error {
 	if userID != nil {
 		template.UserID = *userID
 	}
-	return d.DB.Save(template).Error
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		var latest models.TemplateRevision
+		err := tx.Where("template_id = ?", template.ID).Order("revision desc").Limit(1).Find(&latest).Error
+		if err != nil {
+			return err
+		}
+		if latest.ID == 0 {
+			var stored models.Template
+			if err := tx.First(&stored, template.ID).Error; err != nil {
+				return err
+			}
+			latest = models.TemplateRevision{
+				TemplateID:   stored.ID,
+				Revision:     1,
+				TemplateData: stored.TemplateData,
+				Note:         TemplateRevisionNoteInitial,
+				UserID:       stored.UserID,
+			}
+			if err := tx.Create(&latest).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Save(template).Error; err != nil {
+			return err
+		}
+		if template.TemplateData == latest.TemplateData {
+			return nil
+		}
+		return tx.Create(&models.TemplateRevision{
+			TemplateID:   template.ID,
+			Revision:     latest.Revision + 1,
+			TemplateData: template.TemplateData,
+			Note:         note,
+			UserID:       template.UserID,
+		}).Error
+	})
 }
 
+// ListTemplateRevisions returns the revisions of the template, newest first
+func (d *Database) ListTemplateRevisions(templateID uint, userID *string) ([]models.TemplateRevision, error) {
+	var revisions []models.TemplateRevision
+	query := d.DB.Where("template_id = ?", templateID).Order("revision desc")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.Find(&revisions).Error
+	return revisions, err
+}
+
+// GetTemplateRevision returns a revision of the template by its number
+func (d *Database) GetTemplateRevision(templateID uint, revision int, userID *string) (*models.TemplateRevision, error) {
+	var templateRevision models.TemplateRevision
+	query := d.DB.Where("template_id = ? AND revision = ?", templateID, revision)
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	if err := query.First(&templateRevision).Error; err != nil {
+		return nil, err
+	}
+	return &templateRevision, nil
+}
+
+// DeleteTemplate deletes the template with its revisions
 func (d *Database) DeleteTemplate(id uint, userID *string) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		templates := tx.Where("id = ?", id)
+		revisions := tx.Where("template_id = ?", id)
+		if userID != nil {
+			templates = templates.Where("user_id = ?", *userID)
+			revisions = revisions.Where("user_id = ?", *userID)
+		}
+		if err := templates.Delete(&models.Template{}).Error; err != nil {
+			return err
+		}
+		return revisions.Delete(&models.TemplateRevision{}).Error
+	})
+}
+
+// Partial CRUD operations
+func (d *Database) CreatePartial(partial *models.Partial, userID *string) error {
+	if userID != nil {
+		partial.UserID = *userID
+	}
+	return d.DB.Create(partial).Error
+}
+
+func (d *Database) GetPartialByID(id uint, userID *string) (*models.Partial, error) {
+	var partial models.Partial
 	query := d.DB
 	if userID != nil {
 		query = query.Where("user_id = ?", *userID)
 	}
-	return query.Delete(&models.Template{}, id).Error
+	if err := query.First(&partial, id).Error; err != nil {
+		return nil, err
+	}
+	return &partial, nil
+}
+
+// ListPartials returns the user's partials ordered by name
+func (d *Database) ListPartials(userID *string) ([]models.Partial, error) {
+	var partials []models.Partial
+	query := d.DB.Order("name")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.Find(&partials).Error
+	return partials, err
+}
+
+// PartialSources returns the template data of the user's partials by name
+func (d *Database) PartialSources(userID *string) (map[string]string, error) {
+	partials, err := d.ListPartials(userID)
+	if err != nil {
+		return nil, err
+	}
+	sources := make(map[string]string, len(partials))
+	for _, partial := range partials {
+		sources[partial.Name] = partial.TemplateData
+	}
+	return sources, nil
+}
+
+func (d *Database) UpdatePartial(partial *models.Partial, userID *string) error {
+	if userID != nil {
+		partial.UserID = *userID
+	}
+	return d.DB.Save(partial).Error
+}
+
+func (d *Database) DeletePartial(id uint, userID *string) error {
+	query := d.DB
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	return query.Delete(&models.Partial{}, id).Error
+}
+
+// Cover letter CRUD operations
+func (d *Database) CreateCoverLetter(letter *models.CoverLetter, userID *string) error {
+	if userID != nil {
+		letter.UserID = *userID
+		for i := range letter.Sections {
+			letter.Sections[i].UserID = *userID
+		}
+	}
+	return d.DB.Create(letter).Error
+}
+
+// GetCoverLetterByID returns the cover letter with its sections in order and the full resume it belongs to
+func (d *Database) GetCoverLetterByID(id uint, userID *string) (*models.CoverLetter, error) {
+	var letter models.CoverLetter
+	query := d.DB.Preload("Sections", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	}).
+		Preload("Resume.Contacts").
+		Preload("Resume.WorkExperiences.FeatureMaps").
+		Preload("Resume.Educations.FeatureMaps").
+		Preload("Resume.OtherExperiences.FeatureMaps")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.First(&letter, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &letter, nil
+}
+
+// ListCoverLettersByResumeID returns the cover letters of a resume without their sections, newest first
+func (d *Database) ListCoverLettersByResumeID(resumeID uint, userID *string) ([]models.CoverLetter, error) {
+	var letters []models.CoverLetter
+	query := d.DB.Where("resume_id = ?", resumeID).Order("date desc")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.Find(&letters).Error
+	return letters, err
+}
+
+// UpdateCoverLetter saves the letter's fields. When replaceSections is true the
+// letter's sections are replaced by letter.Sections.
+func (d *Database) UpdateCoverLetter(letter *models.CoverLetter, replaceSections bool, userID *string) error {
+	if userID != nil {
+		letter.UserID = *userID
+	}
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Sections", "Resume").Save(letter).Error; err != nil {
+			return err
+		}
+		if !replaceSections {
+			return nil
+		}
+		if err := tx.Where("cover_letter_id = ?", letter.ID).Delete(&models.CoverLetterSection{}).Error; err != nil {
+			return err
+		}
+		for i := range letter.Sections {
+			letter.Sections[i].ID = 0
+			letter.Sections[i].CoverLetterID = letter.ID
+			letter.Sections[i].UserID = letter.UserID
+		}
+		if len(letter.Sections) == 0 {
+			return nil
+		}
+		return tx.Create(&letter.Sections).Error
+	})
+}
+
+func (d *Database) DeleteCoverLetter(id uint, userID *string) error {
+	return d.DB.Transaction(func(tx *gorm.DB) error {
+		letters := tx.Where("id = ?", id)
+		sections := tx.Where("cover_letter_id = ?", id)
+		if userID != nil {
+			letters = letters.Where("user_id = ?", *userID)
+			sections = sections.Where("user_id = ?", *userID)
+		}
+		if err := letters.Delete(&models.CoverLetter{}).Error; err != nil {
+			return err
+		}
+		return sections.Delete(&models.CoverLetterSection{}).Error
+	})
+}
+
+// GenerateCoverLetterPreview creates a preview session rendering the cover letter with the given template
+func (d *Database) GenerateCoverLetterPreview(letter *models.CoverLetter, template string, css string, userID *string) (string, error) {
+	sessionID := uuid.New().String()
+	session := &models.PreviewSession{
+		ID:            sessionID,
+		ResumeID:      letter.ResumeID,
+		CoverLetterID: &letter.ID,
+		Template:      template,
+		CSS:           css,
+	}
+	if userID != nil {
+		session.UserID = *userID
+	}
+
+	if err := d.DB.Create(session).Error; err != nil {
+		return "", err
+	}
+	return sessionID, nil
+}
+
+// CreateApplicationPacket stores the packet with its documents and returns its ID
+func (d *Database) CreateApplicationPacket(documents []models.PacketDocument, userID *string) (string, error) {
+	packet := &models.ApplicationPacket{
+		ID:        uuid.New().String(),
+		Documents: documents,
+	}
+	if userID != nil {
+		packet.UserID = *userID
+	}
+	for i := range packet.Documents {
+		packet.Documents[i].Position = i
+		packet.Documents[i].UserID = packet.UserID
+	}
+
+	if err := d.DB.Create(packet).Error; err != nil {
+		return "", err
+	}
+	return packet.ID, nil
+}
+
+// GetApplicationPacket returns the packet with its documents in order
+func (d *Database) GetApplicationPacket(packetID string, userID *string) (*models.ApplicationPacket, error) {
+	var packet models.ApplicationPacket
+	query := d.DB.Preload("Documents", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position asc")
+	}).Where("id = ?", packetID)
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	if err := query.First(&packet).Error; err != nil {
+		return nil, err
+	}
+	return &packet, nil
+}
+
+// PacketDocumentData returns what the document's template renders, its cover letter or its resume
+func (d *Database) PacketDocumentData(document *models.PacketDocument, userID *string) (any, error) {
+	if document.CoverLetterID != nil {
+		letter, err := d.GetCoverLetterByID(*document.CoverLetterID, userID)
+		if err != nil {
+			return nil, err
+		}
+		return *letter, nil
+	}
+	resume, err := d.GetResumeByID(document.ResumeID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return *resume, nil
 }
 
 func (d *Database) Close() error {
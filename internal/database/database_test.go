@@ -0,0 +1,85 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// baselineTemplate is the templates table of databases created before user templates,
+// when every template belonged to a resume
+type baselineTemplate struct {
+	ID           uint   `gorm:"primaryKey"`
+	ResumeID     uint   `gorm:"not null"`
+	Name         string `gorm:"not null"`
+	Description  string
+	TemplateData string `gorm:"type:text;not null"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	UserID       string `gorm:"not null"`
+}
+
+func (baselineTemplate) TableName() string {
+	return "templates"
+}
+
+func TestMigrate_UserTemplatesOnBaselineSchema(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resume.db")
+
+	baseline, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := baseline.AutoMigrate(&baselineTemplate{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := baseline.Create(&baselineTemplate{ResumeID: 1, Name: "Existing", TemplateData: "<h1>{{.Name}}</h1>", UserID: "user"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, _ := baseline.DB()
+	sqlDB.Close()
+
+	db, err := NewDatabase(path)
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	defer db.Close()
+
+	if !db.DB.Migrator().HasIndex(&models.Template{}, "ResumeID") {
+		t.Error("Expected the resume_id index to be created")
+	}
+
+	userID := "user"
+	template := &models.Template{Name: "Shared", TemplateData: "<p>{{.Name}}</p>"}
+	if err := db.CreateTemplate(template, &userID); err != nil {
+		t.Fatalf("Failed to create a user template on a migrated database: %v", err)
+	}
+
+	existing, err := db.GetTemplateByID(1, &userID)
+	if err != nil {
+		t.Fatalf("Failed to get the existing template: %v", err)
+	}
+	if existing.ResumeID == nil || *existing.ResumeID != 1 || existing.TemplateData != "<h1>{{.Name}}</h1>" {
+		t.Errorf("Expected the existing template to be kept, got %+v", existing)
+	}
+
+	revisions, err := db.ListTemplateRevisions(existing.ID, &userID)
+	if err != nil {
+		t.Fatalf("Failed to list the revisions of the existing template: %v", err)
+	}
+	if len(revisions) != 1 || revisions[0].Revision != 1 || revisions[0].Note != TemplateRevisionNoteInitial || revisions[0].TemplateData != existing.TemplateData {
+		t.Errorf("Expected the existing template's data backfilled as revision 1, got %+v", revisions)
+	}
+
+	// opening the migrated database again leaves it unchanged
+	if err := db.migrate(); err != nil {
+		t.Errorf("Second migration failed: %v", err)
+	}
+	if revisions, _ := db.ListTemplateRevisions(existing.ID, &userID); len(revisions) != 1 {
+		t.Errorf("Expected no revisions added by the second migration, got %d", len(revisions))
+	}
+}
@@ -0,0 +1,282 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/rxtech-lab/resume-mcp/internal/markdown"
+)
+
+// document collects the paragraphs of document.xml and the hyperlinks they reference
+type document struct {
+	styles Styles
+	body   strings.Builder
+	links  []string
+}
+
+// run is the formatting of a run of text
+type run struct {
+	bold, italic, code bool
+	color              string
+	style              string
+}
+
+// text returns a run of the text, with line breaks for its newlines
+func (d *document) text(text string, format run) string {
+	if text == "" {
+		return ""
+	}
+	var out strings.Builder
+	out.WriteString("<w:r>")
+	var props strings.Builder
+	if format.style != "" {
+		props.WriteString(`<w:rStyle w:val="` + format.style + `"/>`)
+	}
+	if format.code {
+		props.WriteString(`<w:rFonts w:ascii="Consolas" w:hAnsi="Consolas" w:cs="Consolas"/>`)
+	}
+	if format.bold {
+		props.WriteString("<w:b/>")
+	}
+	if format.italic {
+		props.WriteString("<w:i/>")
+	}
+	if format.color != "" {
+		props.WriteString(`<w:color w:val="` + format.color + `"/>`)
+	}
+	if props.Len() > 0 {
+		out.WriteString("<w:rPr>" + props.String() + "</w:rPr>")
+	}
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			out.WriteString("<w:br/>")
+		}
+		for j, part := range strings.Split(line, "\t") {
+			if j > 0 {
+				out.WriteString("<w:tab/>")
+			}
+			if part != "" {
+				out.WriteString(`<w:t xml:space="preserve">` + escape(part) + "</w:t>")
+			}
+		}
+	}
+	out.WriteString("</w:r>")
+	return out.String()
+}
+
+// spans returns the runs of formatted Markdown text, with links as hyperlinks
+func (d *document) spans(spans []markdown.Span) string {
+	var out strings.Builder
+	for _, span := range spans {
+		format := run{bold: span.Bold, italic: span.Italic, code: span.Code}
+		if span.URL == "" {
+			out.WriteString(d.text(span.Text, format))
+			continue
+		}
+		format.style = "Hyperlink"
+		out.WriteString(`<w:hyperlink r:id="` + d.link(span.URL) + `">` + d.text(span.Text, format) + "</w:hyperlink>")
+	}
+	return out.String()
+}
+
+// link returns the relationship id of the hyperlink target
+func (d *document) link(url string) string {
+	for i, link := range d.links {
+		if link == url {
+			return linkID(i)
+		}
+	}
+	d.links = append(d.links, url)
+	return linkID(len(d.links) - 1)
+}
+
+// linkID numbers hyperlinks after the relationships of the styles and numbering parts
+func linkID(index int) string {
+	return fmt.Sprintf("rId%d", index+3)
+}
+
+// paragraph adds a paragraph of the style with extra paragraph properties
+func (d *document) paragraph(style, props, runs string) {
+	d.body.WriteString("<w:p><w:pPr>")
+	if style != "" {
+		d.body.WriteString(`<w:pStyle w:val="` + style + `"/>`)
+	}
+	d.body.WriteString(props + "</w:pPr>" + runs + "</w:p>")
+}
+
+// bullet adds a bullet list item at the nesting level, starting at 0
+func (d *document) bullet(level int, runs string) {
+	d.paragraph("ListBullet", fmt.Sprintf(`<w:numPr><w:ilvl w:val="%d"/><w:numId w:val="1"/></w:numPr>`, min(level, 8)), runs)
+}
+
+// indented adds a paragraph aligned with the text of list items at the level, with an optional
+// marker hanging in front of it like the number of an ordered list item
+func (d *document) indented(level int, marker, runs string) {
+	hanging := ""
+	if marker != "" {
+		hanging = fmt.Sprintf(` w:hanging="%d"`, listHanging)
+		runs = d.text(marker+"\t", run{}) + runs
+	}
+	d.paragraph("ListBullet", fmt.Sprintf(`<w:ind w:left="%d"%s/>`, listIndent(level), hanging), runs)
+}
+
+// markdown adds the blocks of Markdown text. List items are nested below level, so text belonging
+// to a bullet at level 0 is added with level 1.
+func (d *document) markdown(blocks []markdown.Block, level int) {
+	for _, block := range blocks {
+		runs := d.spans(block.Spans)
+		switch {
+		case block.Depth == 0 && level == 0:
+			d.paragraph("", "", runs)
+		case block.Depth == 0:
+			d.indented(level-1, "", runs)
+		case block.Marker == "-":
+			d.bullet(level+block.Depth-1, runs)
+		case block.Marker != "":
+			d.indented(level+block.Depth-1, block.Marker, runs)
+		default:
+			d.indented(level+block.Depth-1, "", runs)
+		}
+	}
+}
+
+// listHanging is the space left for bullets and numbers in front of list items, in twentieths of a point
+const listHanging = 280
+
+// listIndent returns where the text of list items at the level starts
+func listIndent(level int) int {
+	return listHanging + level*360
+}
+
+// zip packages the document with its styles into a DOCX file
+func (d *document) zip(title string) ([]byte, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", packageRelsXML},
+		{"docProps/core.xml", fmt.Sprintf(corePropertiesXML, escape(title))},
+		{"word/_rels/document.xml.rels", d.relationshipsXML()},
+		{"word/document.xml", d.documentXML()},
+		{"word/styles.xml", d.stylesXML()},
+		{"word/numbering.xml", d.numberingXML()},
+	}
+	for _, part := range parts {
+		w, err := archive.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s: %w", part.name, err)
+		}
+		if _, err := w.Write([]byte(xml.Header + part.content)); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", part.name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write docx: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (d *document) documentXML() string {
+	width, height := d.styles.pageSize()
+	margin := d.styles.margin()
+	return `<w:document xmlns:w="` + wordNamespace + `" xmlns:r="` + relationshipsNamespace + `"><w:body>` +
+		d.body.String() +
+		fmt.Sprintf(`<w:sectPr><w:pgSz w:w="%d" w:h="%d"/><w:pgMar w:top="%d" w:right="%d" w:bottom="%d" w:left="%d" w:header="720" w:footer="720" w:gutter="0"/></w:sectPr>`,
+			width, height, margin, margin, margin, margin) +
+		"</w:body></w:document>"
+}
+
+func (d *document) relationshipsXML() string {
+	var out strings.Builder
+	out.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	out.WriteString(`<Relationship Id="rId1" Type="` + officeRelationships + `/styles" Target="styles.xml"/>`)
+	out.WriteString(`<Relationship Id="rId2" Type="` + officeRelationships + `/numbering" Target="numbering.xml"/>`)
+	for i, link := range d.links {
+		out.WriteString(`<Relationship Id="` + linkID(i) + `" Type="` + officeRelationships + `/hyperlink" Target="` + escape(link) + `" TargetMode="External"/>`)
+	}
+	out.WriteString("</Relationships>")
+	return out.String()
+}
+
+func (d *document) stylesXML() string {
+	s := d.styles
+	font := escape(s.FontFamily)
+	headingProps := `<w:b/>`
+	if s.HeadingUppercase {
+		headingProps += `<w:caps/>`
+	}
+	headingProps += `<w:color w:val="` + s.HeadingColor + `"/>` + size(s.HeadingSize)
+	headingBorder := ""
+	if s.HeadingRule {
+		headingBorder = `<w:pBdr><w:bottom w:val="single" w:sz="6" w:space="1" w:color="` + s.HeadingColor + `"/></w:pBdr>`
+	}
+	dateProps := `<w:color w:val="` + s.DateColor + `"/>`
+	if s.DateItalic {
+		dateProps = `<w:i/>` + dateProps
+	}
+	headerAlign := `<w:jc w:val="` + s.HeaderAlign + `"/>`
+
+	return `<w:styles xmlns:w="` + wordNamespace + `">` +
+		`<w:docDefaults><w:rPrDefault><w:rPr><w:rFonts w:ascii="` + font + `" w:hAnsi="` + font + `" w:eastAsia="` + font + `" w:cs="` + font + `"/>` + size(s.FontSize) + `<w:lang w:val="en-US"/></w:rPr></w:rPrDefault>` +
+		`<w:pPrDefault><w:pPr><w:spacing w:after="60" w:line="264" w:lineRule="auto"/></w:pPr></w:pPrDefault></w:docDefaults>` +
+		`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:qFormat/></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Title"><w:name w:val="Title"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:spacing w:after="40"/>` + headerAlign + `</w:pPr><w:rPr><w:b/>` + size(s.NameSize) + `</w:rPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Contact"><w:name w:val="Contact"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:pPr><w:spacing w:after="160"/>` + headerAlign + `</w:pPr><w:rPr><w:color w:val="` + s.DateColor + `"/></w:rPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/>` + headingBorder + `<w:spacing w:before="240" w:after="80"/><w:outlineLvl w:val="0"/></w:pPr><w:rPr>` + headingProps + `</w:rPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Entry"><w:name w:val="Entry"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:spacing w:before="120" w:after="20"/></w:pPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Date"><w:name w:val="Date"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:pPr><w:keepNext/><w:spacing w:after="40"/></w:pPr><w:rPr>` + dateProps + `</w:rPr></w:style>` +
+		`<w:style w:type="character" w:styleId="DateChar"><w:name w:val="Date Char"/><w:rPr>` + dateProps + `</w:rPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="ListBullet"><w:name w:val="List Bullet"/><w:basedOn w:val="Normal"/><w:qFormat/><w:pPr><w:tabs><w:tab w:val="left" w:pos="` + fmt.Sprint(listIndent(0)) + `"/></w:tabs><w:spacing w:after="30"/></w:pPr></w:style>` +
+		`<w:style w:type="character" w:styleId="Hyperlink"><w:name w:val="Hyperlink"/><w:rPr><w:color w:val="1D4ED8"/><w:u w:val="single"/></w:rPr></w:style>` +
+		`</w:styles>`
+}
+
+func (d *document) numberingXML() string {
+	var levels strings.Builder
+	for level := 0; level < 9; level++ {
+		fmt.Fprintf(&levels, `<w:lvl w:ilvl="%d"><w:start w:val="1"/><w:numFmt w:val="bullet"/><w:lvlText w:val="%s"/><w:lvlJc w:val="left"/><w:pPr><w:ind w:left="%d" w:hanging="%d"/></w:pPr><w:rPr><w:rFonts w:ascii="%s" w:hAnsi="%s"/></w:rPr></w:lvl>`,
+			level, escape(d.styles.Bullet), listIndent(level), listHanging, escape(d.styles.FontFamily), escape(d.styles.FontFamily))
+	}
+	return `<w:numbering xmlns:w="` + wordNamespace + `">` +
+		`<w:abstractNum w:abstractNumId="0"><w:multiLevelType w:val="hybridMultilevel"/>` + levels.String() + `</w:abstractNum>` +
+		`<w:num w:numId="1"><w:abstractNumId w:val="0"/></w:num>` +
+		`</w:numbering>`
+}
+
+// size returns the font size property for points
+func size(points float64) string {
+	return fmt.Sprintf(`<w:sz w:val="%d"/><w:szCs w:val="%d"/>`, halfPoints(points), halfPoints(points))
+}
+
+// escape escapes text for XML content and attribute values
+func escape(text string) string {
+	var out strings.Builder
+	xml.EscapeText(&out, []byte(text))
+	return out.String()
+}
+
+const (
+	wordNamespace          = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+	relationshipsNamespace = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	officeRelationships    = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+)
+
+const contentTypesXML = `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>` +
+	`<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>` +
+	`<Override PartName="/word/numbering.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml"/>` +
+	`<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>` +
+	`</Types>`
+
+const packageRelsXML = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" Target="docProps/core.xml"/>` +
+	`</Relationships>`
+
+const corePropertiesXML = `<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" xmlns:dc="http://purl.org/dc/elements/1.1/">` +
+	`<dc:title>%s</dc:title></cp:coreProperties>`
@@ -0,0 +1,211 @@
+// Package docx writes resumes and cover letters as Word documents. The documents are built from the
+// structured data instead of a rendered template, so they use real headings, bullet lists and tab
+// stops that applicant tracking systems and recruiters editing the file can work with.
+package docx
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rxtech-lab/resume-mcp/internal/markdown"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// Format is the name of the DOCX export format, next to the text formats of the service package
+const Format = "docx"
+
+// ContentType is the MIME type of DOCX files
+const ContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+
+// presentLabel ends the date range of ongoing entries
+const presentLabel = "Present"
+
+// Write writes data, a models.Resume or a models.CoverLetter, as a DOCX file
+func Write(data any, styles Styles) ([]byte, error) {
+	switch data := data.(type) {
+	case models.Resume:
+		return Resume(data, styles)
+	case models.CoverLetter:
+		return CoverLetter(data, styles)
+	}
+	return nil, fmt.Errorf("cannot write %T as docx", data)
+}
+
+// FileName is the file name offered when downloading data as a DOCX file
+func FileName(data any) string {
+	if _, ok := data.(models.CoverLetter); ok {
+		return "cover-letter.docx"
+	}
+	return "resume.docx"
+}
+
+// Resume writes the resume as a DOCX file. Work experiences and educations are listed newest first
+// and feature maps become bullets, with their Markdown values formatted.
+func Resume(resume models.Resume, styles Styles) ([]byte, error) {
+	if err := styles.Validate(); err != nil {
+		return nil, err
+	}
+	d := &document{styles: styles}
+	d.header(resume)
+	if resume.Description != "" {
+		d.markdown(markdown.Blocks(resume.Description), 0)
+	}
+
+	if len(resume.WorkExperiences) > 0 {
+		d.heading("Experience")
+		experiences := append([]models.WorkExperience(nil), resume.WorkExperiences...)
+		sort.SliceStable(experiences, func(i, j int) bool {
+			return experiences[i].StartDate.After(experiences[j].StartDate)
+		})
+		for _, experience := range experiences {
+			title := experience.JobTitle
+			if experience.Company != "" {
+				title += ", " + experience.Company
+			}
+			if experience.Type != "" && experience.Type != "fulltime" {
+				title += " (" + experience.Type + ")"
+			}
+			d.entry(title, d.dateRange(experience.Start(), experience.End(), experience.Current()))
+			d.featureMaps(experience.FeatureMaps)
+		}
+	}
+
+	if len(resume.Educations) > 0 {
+		d.heading("Education")
+		educations := append([]models.Education(nil), resume.Educations...)
+		sort.SliceStable(educations, func(i, j int) bool {
+			return educations[i].StartDate.After(educations[j].StartDate)
+		})
+		for _, education := range educations {
+			title := education.SchoolName
+			if education.Category != "" {
+				title += ", " + education.Category
+			}
+			d.entry(title, d.dateRange(education.Start(), education.End(), education.Current()))
+			d.featureMaps(education.FeatureMaps)
+		}
+	}
+
+	for _, other := range resume.OtherExperiences {
+		d.heading(other.Category)
+		d.featureMaps(other.FeatureMaps)
+	}
+
+	return d.zip(resume.Name)
+}
+
+// CoverLetter writes the cover letter as a DOCX file, with the name and contacts of its resume as the letterhead
+func CoverLetter(letter models.CoverLetter, styles Styles) ([]byte, error) {
+	if err := styles.Validate(); err != nil {
+		return nil, err
+	}
+	d := &document{styles: styles}
+	d.header(letter.Resume)
+
+	if !letter.Date.IsZero() {
+		d.paragraph("", `<w:spacing w:after="240"/>`, d.text(letter.Date.Format("January 2, 2006"), run{}))
+	}
+	var address []string
+	if letter.Recipient != "" {
+		address = append(address, letter.Recipient)
+	}
+	if letter.Company != "" {
+		address = append(address, letter.Company)
+	}
+	if len(address) > 0 {
+		d.paragraph("", `<w:spacing w:after="240"/>`, d.text(strings.Join(address, "\n"), run{}))
+	}
+
+	recipient := letter.Recipient
+	if recipient == "" {
+		recipient = "Hiring Manager"
+	}
+	d.paragraph("", `<w:spacing w:after="160"/>`, d.text("Dear "+recipient+",", run{}))
+
+	sections := append([]models.CoverLetterSection(nil), letter.Sections...)
+	sort.SliceStable(sections, func(i, j int) bool {
+		return sections[i].Position < sections[j].Position
+	})
+	for _, section := range sections {
+		if section.Heading != "" {
+			d.paragraph("Entry", "", d.text(section.Heading, run{bold: true}))
+		}
+		d.markdown(markdown.Blocks(section.Body), 0)
+	}
+
+	d.paragraph("", `<w:spacing w:before="240" w:after="480"/>`, d.text("Sincerely,", run{}))
+	d.paragraph("", "", d.text(letter.Resume.Name, run{}))
+
+	return d.zip(letter.Resume.Name + " - Cover Letter")
+}
+
+// header adds the name and the contacts of the resume
+func (d *document) header(resume models.Resume) {
+	d.paragraph("Title", "", d.text(resume.Name, run{}))
+	var contacts []string
+	for _, contact := range resume.Contacts {
+		if contact.Value != "" {
+			contacts = append(contacts, contact.Value)
+		}
+	}
+	if len(contacts) > 0 {
+		d.paragraph("Contact", "", d.text(strings.Join(contacts, " · "), run{}))
+	}
+}
+
+// heading adds a section heading
+func (d *document) heading(text string) {
+	d.paragraph("Heading1", "", d.text(text, run{}))
+}
+
+// entry adds the title line of a work experience or education with its dates, at the right
+// margin or on the line below depending on the styles
+func (d *document) entry(title, dates string) {
+	runs := d.text(title, run{bold: true})
+	if dates == "" {
+		d.paragraph("Entry", "", runs)
+		return
+	}
+	if d.styles.DatePosition == "below" {
+		d.paragraph("Entry", "", runs)
+		d.paragraph("Date", "", d.text(dates, run{}))
+		return
+	}
+	width, _ := d.styles.pageSize()
+	tab := fmt.Sprintf(`<w:tabs><w:tab w:val="right" w:pos="%d"/></w:tabs>`, width-2*d.styles.margin())
+	d.paragraph("Entry", tab, runs+d.text("\t"+dates, run{style: "DateChar"}))
+}
+
+// dateRange formats the dates of an entry with the configured layout
+func (d *document) dateRange(start, end models.PartialDate, current bool) string {
+	if start.IsZero() {
+		return ""
+	}
+	to := presentLabel
+	if !current {
+		to = end.Format(d.styles.DateLayout)
+	}
+	return start.Format(d.styles.DateLayout) + " - " + to
+}
+
+// featureMaps adds the feature maps as bullets starting with their bold key. The first paragraph
+// of a value continues the bullet and the rest of the value, like nested lists, follows indented.
+func (d *document) featureMaps(features []models.FeatureMap) {
+	for _, feature := range features {
+		blocks := markdown.Blocks(feature.Value)
+		var runs string
+		if feature.Key != "" {
+			runs = d.text(feature.Key+":", run{bold: true})
+		}
+		if len(blocks) > 0 && blocks[0].Depth == 0 {
+			if runs != "" {
+				runs += d.text(" ", run{})
+			}
+			runs += d.spans(blocks[0].Spans)
+			blocks = blocks[1:]
+		}
+		d.bullet(0, runs)
+		d.markdown(blocks, 1)
+	}
+}
@@ -0,0 +1,254 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// parts unzips the DOCX file and checks that every part is well-formed XML
+func parts(t *testing.T, file []byte) map[string]string {
+	t.Helper()
+	archive, err := zip.NewReader(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		t.Fatalf("Failed to open docx: %v", err)
+	}
+
+	out := map[string]string{}
+	for _, f := range archive.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatalf("Failed to open %s: %v", f.Name, err)
+		}
+		content, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", f.Name, err)
+		}
+
+		decoder := xml.NewDecoder(bytes.NewReader(content))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%s is not well-formed: %v", f.Name, err)
+			}
+		}
+		out[f.Name] = string(content)
+	}
+	return out
+}
+
+var textRuns = regexp.MustCompile(`<w:t xml:space="preserve">([^<]*)</w:t>|<w:tab/>|</w:p>`)
+
+// documentText returns the text of document.xml with tabs and a newline after every paragraph
+func documentText(document string) string {
+	var out strings.Builder
+	for _, match := range textRuns.FindAllStringSubmatch(document, -1) {
+		switch match[0] {
+		case "<w:tab/>":
+			out.WriteString("\t")
+		case "</w:p>":
+			out.WriteString("\n")
+		default:
+			out.WriteString(html.UnescapeString(match[1]))
+		}
+	}
+	return out.String()
+}
+
+func date(year int, month time.Month) *time.Time {
+	d := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return &d
+}
+
+func testResume() models.Resume {
+	return models.Resume{
+		Name:        "Ada <Lovelace> & Co",
+		Description: "Engineer who ships.",
+		Contacts: []models.Contact{
+			{Key: "email", Value: "ada@example.com"},
+			{Key: "phone", Value: "+1 555 0100"},
+		},
+		WorkExperiences: []models.WorkExperience{
+			{
+				Company: "Analytical Engines", JobTitle: "Engineer", Type: "fulltime",
+				StartDate: *date(2018, time.March), EndDate: date(2020, time.June),
+				StartDatePrecision: models.DatePrecisionMonth, EndDatePrecision: models.DatePrecisionMonth,
+				FeatureMaps: []models.FeatureMap{{Key: "Impact", Value: "Cut latency by **40%**\n\n- Payments\n  - Refunds"}},
+			},
+			{
+				Company: "Babbage Labs", JobTitle: "Lead", Type: "contract",
+				StartDate:          *date(2021, time.January),
+				StartDatePrecision: models.DatePrecisionMonth,
+				FeatureMaps:        []models.FeatureMap{{Value: "See [the post](https://example.com/post?a=1&b=2)"}},
+			},
+		},
+		Educations: []models.Education{
+			{SchoolName: "University of London", Category: "BSc Mathematics", StartDate: *date(2014, time.September), EndDate: date(2017, time.June), StartDatePrecision: models.DatePrecisionYear, EndDatePrecision: models.DatePrecisionYear},
+		},
+		OtherExperiences: []models.OtherExperience{
+			{Category: "Skills", FeatureMaps: []models.FeatureMap{{Key: "Languages", Value: "Go, SQL"}}},
+		},
+	}
+}
+
+// assertContains fails the test unless content contains every expected string
+func assertContains(t *testing.T, name, content string, expected ...string) {
+	t.Helper()
+	for _, e := range expected {
+		if !strings.Contains(content, e) {
+			t.Errorf("Expected %s to contain %q, got:\n%s", name, e, content)
+		}
+	}
+}
+
+func TestResume(t *testing.T) {
+	file, err := Resume(testResume(), DefaultStyles())
+	if err != nil {
+		t.Fatalf("Resume returned error: %v", err)
+	}
+
+	p := parts(t, file)
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "docProps/core.xml", "word/document.xml", "word/_rels/document.xml.rels", "word/styles.xml", "word/numbering.xml"} {
+		if _, ok := p[name]; !ok {
+			t.Errorf("Expected docx to contain %s", name)
+		}
+	}
+
+	expected := `Ada <Lovelace> & Co
+ada@example.com · +1 555 0100
+Engineer who ships.
+Experience
+Lead, Babbage Labs (contract)	Jan 2021 - Present
+See the post
+Engineer, Analytical Engines	Mar 2018 - Jun 2020
+Impact: Cut latency by 40%
+Payments
+Refunds
+Education
+University of London, BSc Mathematics	2014 - 2017
+Skills
+Languages: Go, SQL
+`
+	if text := documentText(p["word/document.xml"]); text != expected {
+		t.Errorf("Expected document text:\n%s\ngot:\n%s", expected, text)
+	}
+
+	// the name is escaped, nested list items are indented a level deeper and dates are aligned to the right margin
+	assertContains(t, "document.xml", p["word/document.xml"],
+		"Ada &lt;Lovelace&gt; &amp; Co",
+		`<w:ilvl w:val="1"/>`,
+		`<w:tab w:val="right" w:pos="10080"/>`,
+	)
+	assertContains(t, "document.xml.rels", p["word/_rels/document.xml.rels"], `Target="https://example.com/post?a=1&amp;b=2" TargetMode="External"`)
+	assertContains(t, "core.xml", p["docProps/core.xml"], "<dc:title>Ada &lt;Lovelace&gt; &amp; Co</dc:title>")
+}
+
+func TestResume_Styles(t *testing.T) {
+	styles, err := ParseStyles([]byte(`{"font_family": "Georgia", "heading_color": "#ff0000", "heading_uppercase": false, "heading_rule": false, "bullet": "–", "date_layout": "01/2006", "date_position": "below", "page_size": "a4", "margin": 1}`))
+	if err != nil {
+		t.Fatalf("ParseStyles returned error: %v", err)
+	}
+
+	file, err := Resume(testResume(), styles)
+	if err != nil {
+		t.Fatalf("Resume returned error: %v", err)
+	}
+	p := parts(t, file)
+
+	assertContains(t, "styles.xml", p["word/styles.xml"], `w:ascii="Georgia"`, `<w:color w:val="FF0000"/>`)
+	for _, unexpected := range []string{"<w:caps/>", "<w:pBdr>"} {
+		if strings.Contains(p["word/styles.xml"], unexpected) {
+			t.Errorf("Expected styles.xml not to contain %q", unexpected)
+		}
+	}
+	assertContains(t, "numbering.xml", p["word/numbering.xml"], `<w:lvlText w:val="–"/>`)
+
+	document := p["word/document.xml"]
+	assertContains(t, "document text", documentText(document), "Engineer, Analytical Engines\n03/2018 - 06/2020\n")
+	assertContains(t, "document.xml", document, `<w:pgSz w:w="11906" w:h="16838"/>`, `w:left="1440"`)
+}
+
+func TestParseStyles(t *testing.T) {
+	styles, err := ParseStyles(nil)
+	if err != nil {
+		t.Fatalf("ParseStyles returned error: %v", err)
+	}
+	if styles != DefaultStyles() {
+		t.Errorf("Expected default styles, got %+v", styles)
+	}
+
+	styles, err = ParseStyles([]byte(`{"font_size": 11}`))
+	if err != nil {
+		t.Fatalf("ParseStyles returned error: %v", err)
+	}
+	if styles.FontSize != 11 || styles.FontFamily != "Calibri" {
+		t.Errorf("Expected font size 11 and the default font, got %v and %q", styles.FontSize, styles.FontFamily)
+	}
+
+	for _, invalid := range []string{
+		`{"font": "Arial"}`,
+		`{"font_size": 200}`,
+		`{"heading_color": "red"}`,
+		`{"date_position": "left"}`,
+		`{"page_size": "legal"}`,
+		`{"margin": 0}`,
+		`{"bullet": ""}`,
+	} {
+		if _, err := ParseStyles([]byte(invalid)); err == nil {
+			t.Errorf("Expected error for %s", invalid)
+		}
+	}
+}
+
+func TestCoverLetter(t *testing.T) {
+	letter := models.CoverLetter{
+		Company: "Acme Corp",
+		Date:    time.Date(2024, time.May, 2, 0, 0, 0, 0, time.UTC),
+		Resume:  testResume(),
+		Sections: []models.CoverLetterSection{
+			{Position: 2, Heading: "Experience", Body: "I built **many** things."},
+			{Position: 1, Body: "I am excited to apply."},
+		},
+	}
+
+	file, err := Write(letter, DefaultStyles())
+	if err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+	if name := FileName(letter); name != "cover-letter.docx" {
+		t.Errorf("Expected file name cover-letter.docx, got %s", name)
+	}
+
+	expected := `Ada <Lovelace> & Co
+ada@example.com · +1 555 0100
+May 2, 2024
+Acme Corp
+Dear Hiring Manager,
+I am excited to apply.
+Experience
+I built many things.
+Sincerely,
+Ada <Lovelace> & Co
+`
+	if text := documentText(parts(t, file)["word/document.xml"]); text != expected {
+		t.Errorf("Expected document text:\n%s\ngot:\n%s", expected, text)
+	}
+}
+
+func TestWrite_UnsupportedData(t *testing.T) {
+	if _, err := Write("resume", DefaultStyles()); err == nil {
+		t.Error("Expected error for unsupported data")
+	}
+}
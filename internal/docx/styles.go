@@ -0,0 +1,129 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Styles configures the look of the written documents. Sizes are in points, colors are hex RGB like "1F2937".
+type Styles struct {
+	FontFamily string  `json:"font_family"`
+	FontSize   float64 `json:"font_size"`
+	NameSize   float64 `json:"name_size"`
+	// HeaderAlign aligns the name and contacts: left or center
+	HeaderAlign      string  `json:"header_align"`
+	HeadingSize      float64 `json:"heading_size"`
+	HeadingColor     string  `json:"heading_color"`
+	HeadingUppercase bool    `json:"heading_uppercase"`
+	// HeadingRule draws a line below section headings
+	HeadingRule bool `json:"heading_rule"`
+	// Bullet is the character of bullet lists
+	Bullet string `json:"bullet"`
+	// DateLayout is a Go time layout, e.g. "Jan 2006" or "01/2006"
+	DateLayout string `json:"date_layout"`
+	// DatePosition places the dates of entries at the right margin of their title line or below it: right or below
+	DatePosition string `json:"date_position"`
+	DateItalic   bool   `json:"date_italic"`
+	DateColor    string `json:"date_color"`
+	// PageSize is letter or a4
+	PageSize string `json:"page_size"`
+	// Margin is the page margin in inches
+	Margin float64 `json:"margin"`
+}
+
+// DefaultStyles returns the styles used for options that aren't configured
+func DefaultStyles() Styles {
+	return Styles{
+		FontFamily:       "Calibri",
+		FontSize:         10.5,
+		NameSize:         22,
+		HeaderAlign:      "left",
+		HeadingSize:      12,
+		HeadingColor:     "1F2937",
+		HeadingUppercase: true,
+		HeadingRule:      true,
+		Bullet:           "•",
+		DateLayout:       "Jan 2006",
+		DatePosition:     "right",
+		DateItalic:       true,
+		DateColor:        "4B5563",
+		PageSize:         "letter",
+		Margin:           0.75,
+	}
+}
+
+// ParseStyles reads styles from JSON, e.g. {"font_family": "Georgia", "date_position": "below"}.
+// Options that aren't given keep their default.
+func ParseStyles(data []byte) (Styles, error) {
+	styles := DefaultStyles()
+	if len(bytes.TrimSpace(data)) == 0 {
+		return styles, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&styles); err != nil {
+		return Styles{}, fmt.Errorf("invalid styles: %w", err)
+	}
+	return styles, styles.Validate()
+}
+
+var hexColor = regexp.MustCompile(`^#?[0-9A-Fa-f]{6}$`)
+
+// Validate checks that the styles can be written
+func (s *Styles) Validate() error {
+	if strings.TrimSpace(s.FontFamily) == "" {
+		return fmt.Errorf("invalid styles: font_family is empty")
+	}
+	for name, size := range map[string]float64{"font_size": s.FontSize, "name_size": s.NameSize, "heading_size": s.HeadingSize} {
+		if size < 4 || size > 96 {
+			return fmt.Errorf("invalid styles: %s must be between 4 and 96 points, got %g", name, size)
+		}
+	}
+	for name, color := range map[string]*string{"heading_color": &s.HeadingColor, "date_color": &s.DateColor} {
+		if !hexColor.MatchString(*color) {
+			return fmt.Errorf("invalid styles: %s must be a hex color like 1F2937, got %q", name, *color)
+		}
+		*color = strings.ToUpper(strings.TrimPrefix(*color, "#"))
+	}
+	if s.HeaderAlign != "left" && s.HeaderAlign != "center" {
+		return fmt.Errorf("invalid styles: header_align must be left or center, got %q", s.HeaderAlign)
+	}
+	if s.DatePosition != "right" && s.DatePosition != "below" {
+		return fmt.Errorf("invalid styles: date_position must be right or below, got %q", s.DatePosition)
+	}
+	if s.PageSize != "letter" && s.PageSize != "a4" {
+		return fmt.Errorf("invalid styles: page_size must be letter or a4, got %q", s.PageSize)
+	}
+	if s.Margin < 0.25 || s.Margin > 2 {
+		return fmt.Errorf("invalid styles: margin must be between 0.25 and 2 inches, got %g", s.Margin)
+	}
+	if n := utf8.RuneCountInString(s.Bullet); n < 1 || n > 2 {
+		return fmt.Errorf("invalid styles: bullet must be one or two characters, got %q", s.Bullet)
+	}
+	if strings.TrimSpace(s.DateLayout) == "" {
+		return fmt.Errorf("invalid styles: date_layout is empty")
+	}
+	return nil
+}
+
+// pageSize returns the width and height of the page in twentieths of a point
+func (s Styles) pageSize() (int, int) {
+	if s.PageSize == "a4" {
+		return 11906, 16838
+	}
+	return 12240, 15840
+}
+
+// margin returns the page margin in twentieths of a point
+func (s Styles) margin() int {
+	return int(s.Margin * 1440)
+}
+
+// halfPoints converts points to the half points font sizes are given in
+func halfPoints(points float64) int {
+	return int(points*2 + 0.5)
+}
@@ -0,0 +1,64 @@
+package events
+
+import "sync"
+
+// subscriberBuffer is the number of events a slow subscriber may fall behind before events are dropped
+const subscriberBuffer = 16
+
+// ChangeEvent describes a change made to a user's resume data
+type ChangeEvent struct {
+	UserID     string
+	ResumeID   uint
+	TemplateID uint
+	// SessionID is set when only a preview session changed, e.g. its CSS
+	SessionID string
+}
+
+// Broker fans out change events to all subscribers in the process
+type Broker struct {
+	mu          sync.RWMutex
+	subscribers map[int]chan ChangeEvent
+	nextID      int
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subscribers: map[int]chan ChangeEvent{},
+	}
+}
+
+// Publish delivers the event to every subscriber without blocking.
+// Subscribers that are not keeping up miss the event.
+func (b *Broker) Publish(event ChangeEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving all published events and a function to unsubscribe
+func (b *Broker) Subscribe() (<-chan ChangeEvent, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	ch := make(chan ChangeEvent, subscriberBuffer)
+	b.subscribers[id] = ch
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, id)
+			close(ch)
+			b.mu.Unlock()
+		})
+	}
+	return ch, unsubscribe
+}
@@ -0,0 +1,41 @@
+package events
+
+import "testing"
+
+func TestBroker_PublishSubscribe(t *testing.T) {
+	broker := NewBroker()
+
+	first, unsubscribeFirst := broker.Subscribe()
+	second, unsubscribeSecond := broker.Subscribe()
+	defer unsubscribeSecond()
+
+	broker.Publish(ChangeEvent{UserID: "user", ResumeID: 1})
+
+	for _, ch := range []<-chan ChangeEvent{first, second} {
+		event := <-ch
+		if event.ResumeID != 1 || event.UserID != "user" {
+			t.Errorf("Unexpected event: %+v", event)
+		}
+	}
+
+	unsubscribeFirst()
+	unsubscribeFirst()
+	if _, ok := <-first; ok {
+		t.Error("Expected channel to be closed after unsubscribe")
+	}
+
+	broker.Publish(ChangeEvent{ResumeID: 2})
+	if event := <-second; event.ResumeID != 2 {
+		t.Errorf("Expected event for resume 2, got %+v", event)
+	}
+}
+
+func TestBroker_PublishDoesNotBlock(t *testing.T) {
+	broker := NewBroker()
+	_, unsubscribe := broker.Subscribe()
+	defer unsubscribe()
+
+	for i := 0; i < subscriberBuffer*2; i++ {
+		broker.Publish(ChangeEvent{ResumeID: uint(i)})
+	}
+}
@@ -0,0 +1,96 @@
+// Package gallery provides the built-in templates shipped with the binary.
+//
+// Every template is a directory under templates/ named after the template, containing
+// template.html (the Go template), meta.json (title, description, type and tags) and
+// thumbnail.svg (a wireframe preview of the layout).
+package gallery
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+)
+
+//go:embed templates
+var files embed.FS
+
+// Template is a built-in template of the gallery
+type Template struct {
+	// Name identifies the template, e.g. to install it
+	Name        string   `json:"name"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Type        string   `json:"type"`
+	Tags        []string `json:"tags"`
+	// TemplateData is the Go template rendering the resume or cover letter
+	TemplateData string `json:"-"`
+	// Thumbnail is an SVG wireframe of the layout
+	Thumbnail []byte `json:"-"`
+}
+
+// ThumbnailMIMEType is the MIME type of template thumbnails
+const ThumbnailMIMEType = "image/svg+xml"
+
+// List returns the built-in templates sorted by name
+func List() ([]Template, error) {
+	entries, err := fs.ReadDir(files, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template gallery: %w", err)
+	}
+
+	templates := make([]Template, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		template, err := load(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, *template)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
+
+// Get returns the built-in template with the given name
+func Get(name string) (*Template, error) {
+	if !fs.ValidPath(name) || path.Dir(name) != "." {
+		return nil, fmt.Errorf("template %q not found in gallery", name)
+	}
+	if _, err := fs.Stat(files, path.Join("templates", name)); err != nil {
+		return nil, fmt.Errorf("template %q not found in gallery", name)
+	}
+	return load(name)
+}
+
+func load(name string) (*Template, error) {
+	dir := path.Join("templates", name)
+
+	meta, err := files.ReadFile(path.Join(dir, "meta.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata of template %q: %w", name, err)
+	}
+	template := &Template{Name: name}
+	if err := json.Unmarshal(meta, template); err != nil {
+		return nil, fmt.Errorf("invalid metadata of template %q: %w", name, err)
+	}
+	template.Name = name
+
+	data, err := files.ReadFile(path.Join(dir, "template.html"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template %q: %w", name, err)
+	}
+	template.TemplateData = string(data)
+
+	template.Thumbnail, err = files.ReadFile(path.Join(dir, "thumbnail.svg"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read thumbnail of template %q: %w", name, err)
+	}
+	return template, nil
+}
@@ -0,0 +1,175 @@
+package gallery
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/tailwind"
+)
+
+func sampleData(templateType string, resume models.Resume) any {
+	if templateType != models.TemplateTypeCoverLetter {
+		return resume
+	}
+	return models.CoverLetter{
+		Recipient: "Alex Smith",
+		Company:   "Example Inc.",
+		Date:      time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Resume:    resume,
+		Sections: []models.CoverLetterSection{
+			{Body: "I am writing to apply for the Staff Engineer role."},
+			{Heading: "Why me", Body: "I have built payment systems for ten years."},
+		},
+	}
+}
+
+func TestList(t *testing.T) {
+	templates, err := List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(templates) < 4 {
+		t.Fatalf("Expected at least 4 built-in templates, got %d", len(templates))
+	}
+
+	seenTypes := map[string]bool{}
+	for i, template := range templates {
+		if i > 0 && templates[i-1].Name >= template.Name {
+			t.Errorf("Templates not sorted by name: %q before %q", templates[i-1].Name, template.Name)
+		}
+		if template.Title == "" || template.Description == "" {
+			t.Errorf("Template %q has no title or description", template.Name)
+		}
+		if template.Type != models.TemplateTypeResume && template.Type != models.TemplateTypeCoverLetter {
+			t.Errorf("Template %q has invalid type %q", template.Name, template.Type)
+		}
+		seenTypes[template.Type] = true
+
+		if strings.TrimSpace(template.TemplateData) == "" {
+			t.Errorf("Template %q is empty", template.Name)
+		}
+		if err := xml.Unmarshal(template.Thumbnail, new(struct{})); err != nil || !strings.Contains(string(template.Thumbnail), "<svg") {
+			t.Errorf("Template %q has no valid SVG thumbnail: %v", template.Name, err)
+		}
+	}
+
+	if !seenTypes[models.TemplateTypeResume] || !seenTypes[models.TemplateTypeCoverLetter] {
+		t.Errorf("Expected resume and cover letter templates, got types %v", seenTypes)
+	}
+}
+
+func TestGet(t *testing.T) {
+	template, err := Get("classic")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if template.Name != "classic" || template.Type != models.TemplateTypeResume {
+		t.Errorf("Unexpected template: %+v", template)
+	}
+
+	for _, name := range []string{"missing", "", "../gallery", "classic/template.html", "."} {
+		if _, err := Get(name); err == nil {
+			t.Errorf("Expected error for template %q", name)
+		}
+	}
+}
+
+func TestTemplatesRenderSampleResumes(t *testing.T) {
+	templates, err := List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	templateService := service.NewTemplateService()
+	for _, template := range templates {
+		for _, sample := range service.EdgeCaseResumes() {
+			resume := sample.Data.(models.Resume)
+			t.Run(template.Name+"/"+sample.Name, func(t *testing.T) {
+				html, err := templateService.GeneratePreview(template.TemplateData, "", sampleData(template.Type, resume))
+				if err != nil {
+					t.Fatalf("Failed to render: %v", err)
+				}
+				if strings.Contains(html, "<no value>") {
+					t.Error("Rendered output contains <no value>")
+				}
+
+				escapedName := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "'", "&#39;").Replace(resume.Name)
+				if !strings.Contains(html, escapedName) {
+					t.Errorf("Expected rendered output to contain the name %q", escapedName)
+				}
+				for _, experience := range resume.WorkExperiences {
+					if template.Type == models.TemplateTypeResume && !strings.Contains(html, experience.JobTitle) {
+						t.Errorf("Expected rendered output to contain job title %q", experience.JobTitle)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestTemplatesRespectDatePrecision(t *testing.T) {
+	templates, err := List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	// The education of the full sample is only known to the year and the current jobs have no end date
+	expected := map[string]string{"full": "2012 - 2016", "current jobs": "Present"}
+
+	templateService := service.NewTemplateService()
+	for _, template := range templates {
+		if template.Type != models.TemplateTypeResume {
+			continue
+		}
+		for _, sample := range service.EdgeCaseResumes() {
+			text, ok := expected[sample.Name]
+			if !ok {
+				continue
+			}
+			html, err := templateService.GeneratePreview(template.TemplateData, "", sample.Data)
+			if err != nil {
+				t.Fatalf("Failed to render %q: %v", template.Name, err)
+			}
+			if !strings.Contains(html, text) {
+				t.Errorf("Template %q doesn't print %q for the %s sample", template.Name, text, sample.Name)
+			}
+		}
+	}
+}
+
+func TestTemplatesPassValidation(t *testing.T) {
+	templates, err := List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	templateService := service.NewTemplateService()
+	for _, template := range templates {
+		var samples []service.ValidationSample
+		for _, sample := range service.EdgeCaseResumes() {
+			samples = append(samples, service.ValidationSample{Name: sample.Name, Data: sampleData(template.Type, sample.Data.(models.Resume))})
+		}
+
+		validation := templateService.ValidateTemplate(template.TemplateData, samples)
+		for _, issue := range validation.Issues {
+			t.Errorf("Template %q: %s line %d: %s %v", template.Name, issue.Template, issue.Line, issue.Message, issue.Samples)
+		}
+	}
+}
+
+func TestTemplatesUseSupportedClasses(t *testing.T) {
+	templates, err := List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	for _, template := range templates {
+		if _, unsupported := tailwind.Generate(tailwind.Classes(template.TemplateData)); len(unsupported) > 0 {
+			t.Errorf("Template %q uses classes without generated styles: %v", template.Name, unsupported)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package jsonresume
+
+import (
+	"strings"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// Export converts a resume into a JSON Resume document. Contacts and feature maps created by
+// Import are written back to their fields, others are mapped by their key where the key names
+// a field, e.g. an "Email" contact, and otherwise become profiles, highlights, courses or skills.
+func Export(resume models.Resume) Document {
+	document := Document{
+		Schema: SchemaURL,
+		Basics: Basics{
+			Name:    resume.Name,
+			Image:   resume.Photo,
+			Summary: resume.Description,
+		},
+	}
+	exportContacts(&document.Basics, resume.Contacts)
+
+	for _, experience := range resume.WorkExperiences {
+		work := Work{
+			Name:      experience.Company,
+			Position:  experience.JobTitle,
+			StartDate: experience.Start().String(),
+			EndDate:   experience.End().String(),
+		}
+		for _, featureMap := range experience.FeatureMaps {
+			var field *string
+			switch featureMap.Category {
+			case CategorySummary:
+				field = &work.Summary
+			case CategoryDescription:
+				field = &work.Description
+			case CategoryLocation:
+				field = &work.Location
+			case CategoryURL:
+				field = &work.URL
+			}
+			if !setOnce(field, featureMap.Value) {
+				work.Highlights = append(work.Highlights, featureText(featureMap))
+			}
+		}
+		document.Work = append(document.Work, work)
+	}
+
+	for _, entry := range resume.Educations {
+		education := Education{
+			Institution: entry.SchoolName,
+			StudyType:   entry.Category,
+			StartDate:   entry.Start().String(),
+			EndDate:     entry.End().String(),
+		}
+		for _, featureMap := range entry.FeatureMaps {
+			var field *string
+			switch featureMap.Category {
+			case CategoryArea:
+				field = &education.Area
+			case CategoryScore:
+				field = &education.Score
+			case CategoryURL:
+				field = &education.URL
+			}
+			if !setOnce(field, featureMap.Value) {
+				education.Courses = append(education.Courses, featureText(featureMap))
+			}
+		}
+		document.Education = append(document.Education, education)
+	}
+
+	for _, experience := range resume.OtherExperiences {
+		exportOtherExperience(&document, experience)
+	}
+
+	return document
+}
+
+func exportContacts(basics *Basics, contacts []models.Contact) {
+	location := &Location{}
+	for _, contact := range contacts {
+		category := contact.Category
+		if category == "" {
+			category = contactCategory(contact.Key)
+		}
+
+		var field *string
+		switch category {
+		case CategoryLabel:
+			field = &basics.Label
+		case CategoryEmail:
+			field = &basics.Email
+		case CategoryPhone:
+			field = &basics.Phone
+		case CategoryURL:
+			field = &basics.URL
+		case CategoryLocation + ".address":
+			field = &location.Address
+		case CategoryLocation + ".postalCode":
+			field = &location.PostalCode
+		case CategoryLocation + ".city":
+			field = &location.City
+		case CategoryLocation + ".countryCode":
+			field = &location.CountryCode
+		case CategoryLocation + ".region":
+			field = &location.Region
+		case CategoryProfileUsername:
+			if last := len(basics.Profiles) - 1; last >= 0 && basics.Profiles[last].Network == contact.Key && basics.Profiles[last].Username == "" {
+				basics.Profiles[last].Username = contact.Value
+				continue
+			}
+			basics.Profiles = append(basics.Profiles, Profile{Network: contact.Key, Username: contact.Value})
+			continue
+		}
+		if setOnce(field, contact.Value) {
+			continue
+		}
+
+		profile := Profile{Network: contact.Key}
+		if category == CategoryProfile || isURL(contact.Value) {
+			profile.URL = contact.Value
+		} else {
+			profile.Username = contact.Value
+		}
+		basics.Profiles = append(basics.Profiles, profile)
+	}
+	if *location != (Location{}) {
+		basics.Location = location
+	}
+}
+
+// contactCategory maps the key of a contact added without category to the field it names
+func contactCategory(key string) string {
+	switch strings.ToLower(strings.TrimSpace(key)) {
+	case "email", "e-mail", "mail":
+		return CategoryEmail
+	case "phone", "mobile", "tel", "telephone":
+		return CategoryPhone
+	case "url", "website", "homepage", "web":
+		return CategoryURL
+	case "address", "location":
+		return CategoryLocation + ".address"
+	case "city":
+		return CategoryLocation + ".city"
+	case "label", "title", "headline":
+		return CategoryLabel
+	}
+	return ""
+}
+
+// exportOtherExperience adds the skills, projects and awards of an other experience. Detail feature
+// maps belong to the entry before them, feature maps without a known category become skills.
+func exportOtherExperience(document *Document, experience models.OtherExperience) {
+	// indices of the current entries, entries are appended so pointers to them would go stale
+	skillIndex, projectIndex, awardIndex := -1, -1, -1
+
+	for _, featureMap := range experience.FeatureMaps {
+		category, detail, _ := strings.Cut(featureMap.Category, ".")
+		switch category {
+		case CategorySkill:
+			if detail == "" || skillIndex < 0 {
+				document.Skills = append(document.Skills, Skill{})
+				skillIndex = len(document.Skills) - 1
+			}
+			skill := &document.Skills[skillIndex]
+			switch detail {
+			case "":
+				skill.Name, skill.Keywords = featureMap.Key, splitList(featureMap.Value)
+			case "level":
+				skill.Level = featureMap.Value
+			}
+		case CategoryProject:
+			if detail == "" || projectIndex < 0 {
+				document.Projects = append(document.Projects, Project{})
+				projectIndex = len(document.Projects) - 1
+			}
+			project := &document.Projects[projectIndex]
+			switch detail {
+			case "":
+				project.Name, project.Description = featureMap.Key, featureMap.Value
+			case CategoryHighlight:
+				project.Highlights = append(project.Highlights, featureMap.Value)
+			case "keywords":
+				project.Keywords = splitList(featureMap.Value)
+			case "startDate":
+				project.StartDate = featureMap.Value
+			case "endDate":
+				project.EndDate = featureMap.Value
+			case CategoryURL:
+				project.URL = featureMap.Value
+			case "roles":
+				project.Roles = splitList(featureMap.Value)
+			case "entity":
+				project.Entity = featureMap.Value
+			case "type":
+				project.Type = featureMap.Value
+			}
+		case CategoryAward:
+			if detail == "" || awardIndex < 0 {
+				document.Awards = append(document.Awards, Award{})
+				awardIndex = len(document.Awards) - 1
+			}
+			award := &document.Awards[awardIndex]
+			switch detail {
+			case "":
+				award.Title, award.Summary = featureMap.Key, featureMap.Value
+			case "date":
+				award.Date = featureMap.Value
+			case "awarder":
+				award.Awarder = featureMap.Value
+			}
+		default:
+			name := featureMap.Key
+			if name == "" {
+				name = experience.Category
+			}
+			document.Skills = append(document.Skills, Skill{Name: name, Keywords: splitList(featureMap.Value)})
+			skillIndex = -1
+		}
+	}
+}
+
+// setOnce sets the field if there is one and it is still empty
+func setOnce(field *string, value string) bool {
+	if field == nil || *field != "" {
+		return false
+	}
+	*field = value
+	return true
+}
+
+// featureText returns a feature map as a single line for list fields such as highlights
+func featureText(featureMap models.FeatureMap) string {
+	if featureMap.Key == "" {
+		return featureMap.Value
+	}
+	return featureMap.Key + ": " + featureMap.Value
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func isURL(value string) bool {
+	return strings.Contains(value, "://") || strings.HasPrefix(value, "www.")
+}
@@ -0,0 +1,203 @@
+package jsonresume
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// Categories of the contacts and feature maps holding JSON Resume fields. The details of profiles,
+// skills, projects and awards follow the contact or feature map of their entry, which names it.
+const (
+	CategoryLabel           = "label"
+	CategoryEmail           = "email"
+	CategoryPhone           = "phone"
+	CategoryURL             = "url"
+	CategoryLocation        = "location"
+	CategoryProfile         = "profile"
+	CategoryProfileUsername = "profile.username"
+
+	CategorySummary     = "summary"
+	CategoryDescription = "description"
+	CategoryHighlight   = "highlight"
+	CategoryArea        = "area"
+	CategoryScore       = "score"
+	CategoryCourse      = "course"
+
+	CategorySkill   = "skill"
+	CategoryProject = "project"
+	CategoryAward   = "award"
+)
+
+// Categories of the other experiences holding skills, projects and awards
+const (
+	SectionSkills   = "Skills"
+	SectionProjects = "Projects"
+	SectionAwards   = "Awards"
+)
+
+// listSeparator joins skill keywords and project roles into a single feature map value
+const listSeparator = ", "
+
+// Import converts a JSON Resume document into a resume that can be created with
+// database.ImportResume. Dates must be in YYYY, YYYY-MM or YYYY-MM-DD format.
+func Import(document Document) (*models.Resume, error) {
+	basics := document.Basics
+	if strings.TrimSpace(basics.Name) == "" {
+		return nil, errors.New("basics.name is required")
+	}
+
+	resume := &models.Resume{
+		Name:        basics.Name,
+		Photo:       basics.Image,
+		Description: basics.Summary,
+		Contacts:    importContacts(basics),
+	}
+
+	for i, work := range document.Work {
+		experience := models.WorkExperience{
+			Company:  work.Name,
+			JobTitle: work.Position,
+			Type:     "fulltime",
+		}
+		if err := importDates(work.StartDate, work.EndDate, &experience.StartDate, &experience.StartDatePrecision, &experience.EndDate, &experience.EndDatePrecision); err != nil {
+			return nil, fmt.Errorf("work[%d]: %w", i, err)
+		}
+		experience.FeatureMaps = featureMaps(
+			feature{"Summary", work.Summary, CategorySummary},
+			feature{"Description", work.Description, CategoryDescription},
+			feature{"Location", work.Location, CategoryLocation},
+			feature{"URL", work.URL, CategoryURL},
+		)
+		for _, highlight := range work.Highlights {
+			experience.FeatureMaps = append(experience.FeatureMaps, models.FeatureMap{Value: highlight, Category: CategoryHighlight})
+		}
+		resume.WorkExperiences = append(resume.WorkExperiences, experience)
+	}
+
+	for i, education := range document.Education {
+		entry := models.Education{
+			SchoolName: education.Institution,
+			Type:       "fulltime",
+			Category:   education.StudyType,
+		}
+		if err := importDates(education.StartDate, education.EndDate, &entry.StartDate, &entry.StartDatePrecision, &entry.EndDate, &entry.EndDatePrecision); err != nil {
+			return nil, fmt.Errorf("education[%d]: %w", i, err)
+		}
+		entry.FeatureMaps = featureMaps(
+			feature{"Area", education.Area, CategoryArea},
+			feature{"Score", education.Score, CategoryScore},
+			feature{"URL", education.URL, CategoryURL},
+		)
+		for _, course := range education.Courses {
+			entry.FeatureMaps = append(entry.FeatureMaps, models.FeatureMap{Value: course, Category: CategoryCourse})
+		}
+		resume.Educations = append(resume.Educations, entry)
+	}
+
+	if len(document.Skills) > 0 {
+		section := models.OtherExperience{Category: SectionSkills}
+		for _, skill := range document.Skills {
+			section.FeatureMaps = append(section.FeatureMaps, models.FeatureMap{Key: skill.Name, Value: strings.Join(skill.Keywords, listSeparator), Category: CategorySkill})
+			section.FeatureMaps = append(section.FeatureMaps, featureMaps(feature{"Level", skill.Level, CategorySkill + ".level"})...)
+		}
+		resume.OtherExperiences = append(resume.OtherExperiences, section)
+	}
+
+	if len(document.Projects) > 0 {
+		section := models.OtherExperience{Category: SectionProjects}
+		for _, project := range document.Projects {
+			section.FeatureMaps = append(section.FeatureMaps, models.FeatureMap{Key: project.Name, Value: project.Description, Category: CategoryProject})
+			for _, highlight := range project.Highlights {
+				section.FeatureMaps = append(section.FeatureMaps, models.FeatureMap{Value: highlight, Category: CategoryProject + "." + CategoryHighlight})
+			}
+			section.FeatureMaps = append(section.FeatureMaps, featureMaps(
+				feature{"Keywords", strings.Join(project.Keywords, listSeparator), CategoryProject + ".keywords"},
+				feature{"Start Date", project.StartDate, CategoryProject + ".startDate"},
+				feature{"End Date", project.EndDate, CategoryProject + ".endDate"},
+				feature{"URL", project.URL, CategoryProject + "." + CategoryURL},
+				feature{"Roles", strings.Join(project.Roles, listSeparator), CategoryProject + ".roles"},
+				feature{"Entity", project.Entity, CategoryProject + ".entity"},
+				feature{"Type", project.Type, CategoryProject + ".type"},
+			)...)
+		}
+		resume.OtherExperiences = append(resume.OtherExperiences, section)
+	}
+
+	if len(document.Awards) > 0 {
+		section := models.OtherExperience{Category: SectionAwards}
+		for _, award := range document.Awards {
+			section.FeatureMaps = append(section.FeatureMaps, models.FeatureMap{Key: award.Title, Value: award.Summary, Category: CategoryAward})
+			section.FeatureMaps = append(section.FeatureMaps, featureMaps(
+				feature{"Date", award.Date, CategoryAward + ".date"},
+				feature{"Awarder", award.Awarder, CategoryAward + ".awarder"},
+			)...)
+		}
+		resume.OtherExperiences = append(resume.OtherExperiences, section)
+	}
+
+	return resume, nil
+}
+
+func importContacts(basics Basics) []models.Contact {
+	var contacts []models.Contact
+	add := func(key, value, category string) {
+		if value != "" {
+			contacts = append(contacts, models.Contact{Key: key, Value: value, Category: category})
+		}
+	}
+
+	add("Label", basics.Label, CategoryLabel)
+	add("Email", basics.Email, CategoryEmail)
+	add("Phone", basics.Phone, CategoryPhone)
+	add("Website", basics.URL, CategoryURL)
+	if location := basics.Location; location != nil {
+		add("Address", location.Address, CategoryLocation+".address")
+		add("Postal Code", location.PostalCode, CategoryLocation+".postalCode")
+		add("City", location.City, CategoryLocation+".city")
+		add("Country Code", location.CountryCode, CategoryLocation+".countryCode")
+		add("Region", location.Region, CategoryLocation+".region")
+	}
+	for _, profile := range basics.Profiles {
+		contacts = append(contacts, models.Contact{Key: profile.Network, Value: profile.URL, Category: CategoryProfile})
+		add(profile.Network, profile.Username, CategoryProfileUsername)
+	}
+	return contacts
+}
+
+// importDates parses the start and end date of an entry, an empty end date marks an ongoing entry
+func importDates(start, end string, startDate *time.Time, startPrecision *string, endDate **time.Time, endPrecision *string) error {
+	if start != "" {
+		date, err := models.ParsePartialDate(start)
+		if err != nil {
+			return fmt.Errorf("startDate: %w", err)
+		}
+		*startDate, *startPrecision = date.Time, date.Precision
+	}
+	date, err := models.ParseEndDate(end)
+	if err != nil {
+		return fmt.Errorf("endDate: %w", err)
+	}
+	if date != nil {
+		*endDate, *endPrecision = &date.Time, date.Precision
+	}
+	return nil
+}
+
+type feature struct {
+	key, value, category string
+}
+
+// featureMaps returns the feature maps of the features with a value
+func featureMaps(features ...feature) []models.FeatureMap {
+	var maps []models.FeatureMap
+	for _, f := range features {
+		if f.value != "" {
+			maps = append(maps, models.FeatureMap{Key: f.key, Value: f.value, Category: f.category})
+		}
+	}
+	return maps
+}
@@ -0,0 +1,143 @@
+// Package jsonresume converts between resumes and the JSON Resume format (https://jsonresume.org),
+// the common exchange format of resume builders and themes.
+//
+// The fields of the format without a counterpart in models.Resume are kept as contacts and feature
+// maps whose category names the field, e.g. a work summary becomes a feature map with category
+// "summary", so a document exported after an import equals the imported document.
+package jsonresume
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SchemaURL is the version of the JSON Resume schema written by Export
+const SchemaURL = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// Document is a JSON Resume document, limited to the sections imported into resumes
+type Document struct {
+	Schema    string      `json:"$schema,omitempty"`
+	Basics    Basics      `json:"basics"`
+	Work      []Work      `json:"work,omitempty"`
+	Education []Education `json:"education,omitempty"`
+	Skills    []Skill     `json:"skills,omitempty"`
+	Projects  []Project   `json:"projects,omitempty"`
+	Awards    []Award     `json:"awards,omitempty"`
+}
+
+type Basics struct {
+	Name     string    `json:"name,omitempty"`
+	Label    string    `json:"label,omitempty"`
+	Image    string    `json:"image,omitempty"`
+	Email    string    `json:"email,omitempty"`
+	Phone    string    `json:"phone,omitempty"`
+	URL      string    `json:"url,omitempty"`
+	Summary  string    `json:"summary,omitempty"`
+	Location *Location `json:"location,omitempty"`
+	Profiles []Profile `json:"profiles,omitempty"`
+}
+
+type Location struct {
+	Address     string `json:"address,omitempty"`
+	PostalCode  string `json:"postalCode,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+type Profile struct {
+	Network  string `json:"network,omitempty"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+type Work struct {
+	Name        string   `json:"name,omitempty"`
+	Position    string   `json:"position,omitempty"`
+	Location    string   `json:"location,omitempty"`
+	Description string   `json:"description,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Summary     string   `json:"summary,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+}
+
+type Education struct {
+	Institution string   `json:"institution,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Area        string   `json:"area,omitempty"`
+	StudyType   string   `json:"studyType,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	Score       string   `json:"score,omitempty"`
+	Courses     []string `json:"courses,omitempty"`
+}
+
+type Skill struct {
+	Name     string   `json:"name,omitempty"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+type Project struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	Entity      string   `json:"entity,omitempty"`
+	Type        string   `json:"type,omitempty"`
+}
+
+type Award struct {
+	Title   string `json:"title,omitempty"`
+	Date    string `json:"date,omitempty"`
+	Awarder string `json:"awarder,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// supportedSections are the top level keys read by Parse, other sections are reported as skipped
+var supportedSections = map[string]bool{
+	"$schema":   true,
+	"meta":      true,
+	"basics":    true,
+	"work":      true,
+	"education": true,
+	"skills":    true,
+	"projects":  true,
+	"awards":    true,
+}
+
+// Parse decodes a JSON Resume document. The warnings name the non-empty sections,
+// such as volunteer or languages, that have no place in a resume and are skipped.
+func Parse(data []byte) (Document, []string, error) {
+	var document Document
+	if err := json.Unmarshal(data, &document); err != nil {
+		return Document{}, nil, fmt.Errorf("invalid JSON Resume document: %w", err)
+	}
+
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return Document{}, nil, fmt.Errorf("invalid JSON Resume document: %w", err)
+	}
+
+	var warnings []string
+	for name, value := range sections {
+		if supportedSections[name] {
+			continue
+		}
+		switch strings.TrimSpace(string(value)) {
+		case "null", "[]", "{}", `""`:
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("Skipped the %s section, it is not supported", name))
+	}
+	sort.Strings(warnings)
+	return document, warnings, nil
+}
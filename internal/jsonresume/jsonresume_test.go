@@ -0,0 +1,233 @@
+package jsonresume
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+const testDocument = `{
+  "$schema": "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json",
+  "basics": {
+    "name": "Richard Hendriks",
+    "label": "Programmer",
+    "image": "https://example.com/richard.jpg",
+    "email": "richard@piedpiper.example",
+    "phone": "(912) 555-4321",
+    "url": "https://richard.example",
+    "summary": "Richard hails from **Tulsa**.",
+    "location": {
+      "address": "2712 Broadway St",
+      "postalCode": "CA 94115",
+      "city": "San Francisco",
+      "countryCode": "US",
+      "region": "California"
+    },
+    "profiles": [
+      {"network": "Twitter", "username": "neutralthoughts", "url": "https://twitter.com/neutralthoughts"},
+      {"network": "SoundCloud", "username": "dandymusicnl"},
+      {"network": "GitHub", "url": "https://github.com/richard"}
+    ]
+  },
+  "work": [
+    {
+      "name": "Pied Piper",
+      "position": "CEO/President",
+      "location": "Palo Alto, CA",
+      "description": "Compression company",
+      "url": "https://piedpiper.example",
+      "startDate": "2013-12",
+      "summary": "Pied Piper is a multi-platform technology.",
+      "highlights": ["Build an algorithm", "Successfully won Techcrunch Disrupt"]
+    },
+    {
+      "name": "Hooli",
+      "position": "Engineer",
+      "startDate": "2010",
+      "endDate": "2013-11-30"
+    }
+  ],
+  "education": [
+    {
+      "institution": "University of Oklahoma",
+      "url": "https://www.ou.edu/",
+      "area": "Information Technology",
+      "studyType": "Bachelor",
+      "startDate": "2011-06-01",
+      "endDate": "2014-01-01",
+      "score": "4.0",
+      "courses": ["DB1101 - Basic SQL", "CS2011 - Java Introduction"]
+    }
+  ],
+  "skills": [
+    {"name": "Web Development", "level": "Master", "keywords": ["HTML", "CSS", "JavaScript"]},
+    {"name": "Compression", "keywords": ["Mpeg", "MP4"]}
+  ],
+  "projects": [
+    {
+      "name": "Miss Direction",
+      "description": "A mapping engine that misguides you",
+      "highlights": ["Won award at AIHacks 2016", "Built by all women team"],
+      "keywords": ["GoogleMaps", "Chrome Extension"],
+      "startDate": "2016-08-24",
+      "endDate": "2016-08-24",
+      "url": "https://missdirection.example",
+      "roles": ["Team Lead", "Designer"],
+      "entity": "Smoogle",
+      "type": "application"
+    },
+    {"name": "Side project"}
+  ],
+  "awards": [
+    {"title": "Digital Compression Pioneer Award", "date": "2014-11-01", "awarder": "Techcrunch", "summary": "There is no spoon."}
+  ]
+}`
+
+func TestRoundTrip(t *testing.T) {
+	document, warnings, err := Parse([]byte(testDocument))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("Expected no warnings, got %v", warnings)
+	}
+
+	resume, err := Import(document)
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	exported, err := json.Marshal(Export(*resume))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var expected, actual any
+	if err := json.Unmarshal([]byte(testDocument), &expected); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(exported, &actual); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Round trip changed the document:\n%s", exported)
+	}
+}
+
+func TestImport(t *testing.T) {
+	document, _, err := Parse([]byte(testDocument))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	resume, err := Import(document)
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	if resume.Name != "Richard Hendriks" || resume.Photo != "https://example.com/richard.jpg" || resume.Description != "Richard hails from **Tulsa**." {
+		t.Errorf("Unexpected basic info: %+v", resume)
+	}
+	if len(resume.WorkExperiences) != 2 || len(resume.Educations) != 1 || len(resume.OtherExperiences) != 3 {
+		t.Fatalf("Unexpected entries: %d work, %d education, %d other", len(resume.WorkExperiences), len(resume.Educations), len(resume.OtherExperiences))
+	}
+
+	current := resume.WorkExperiences[0]
+	if current.Company != "Pied Piper" || current.JobTitle != "CEO/President" || !current.Current() {
+		t.Errorf("Unexpected work experience: %+v", current)
+	}
+	if start := current.Start(); start.Precision != models.DatePrecisionMonth || !start.Time.Equal(time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected start in December 2013, got %v", start)
+	}
+	if end := resume.WorkExperiences[1].End(); end.String() != "2013-11-30" {
+		t.Errorf("Expected end date 2013-11-30, got %s", end)
+	}
+
+	education := resume.Educations[0]
+	if education.SchoolName != "University of Oklahoma" || education.Category != "Bachelor" {
+		t.Errorf("Unexpected education: %+v", education)
+	}
+
+	for i, section := range []string{SectionSkills, SectionProjects, SectionAwards} {
+		if resume.OtherExperiences[i].Category != section {
+			t.Errorf("Expected other experience %d to be %s, got %s", i, section, resume.OtherExperiences[i].Category)
+		}
+	}
+	skill := resume.OtherExperiences[0].FeatureMaps[0]
+	if skill.Key != "Web Development" || skill.Value != "HTML, CSS, JavaScript" {
+		t.Errorf("Expected skill keywords as value, got %+v", skill)
+	}
+}
+
+func TestImport_Errors(t *testing.T) {
+	if _, err := Import(Document{}); err == nil {
+		t.Error("Expected error for a document without name")
+	}
+
+	_, err := Import(Document{Basics: Basics{Name: "Jane"}, Work: []Work{{Name: "Acme", StartDate: "June 2020"}}})
+	if err == nil || !strings.Contains(err.Error(), "work[0]: startDate") {
+		t.Errorf("Expected start date error, got %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	_, warnings, err := Parse([]byte(`{"basics": {"name": "Jane"}, "volunteer": [{"organization": "Red Cross"}], "languages": [], "meta": {"version": "v1"}}`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(warnings, []string{"Skipped the volunteer section, it is not supported"}) {
+		t.Errorf("Unexpected warnings: %v", warnings)
+	}
+
+	if _, _, err := Parse([]byte(`{"basics": []}`)); err == nil {
+		t.Error("Expected error for an invalid document")
+	}
+}
+
+func TestExport(t *testing.T) {
+	endDate := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC)
+	resume := models.Resume{
+		Name: "Jane Doe",
+		Contacts: []models.Contact{
+			{Key: "Email", Value: "jane@example.com"},
+			{Key: "phone", Value: "+1 555 0100"},
+			{Key: "LinkedIn", Value: "https://linkedin.com/in/jane"},
+			{Key: "GitHub", Value: "janedoe"},
+		},
+		WorkExperiences: []models.WorkExperience{{
+			Company: "Bank Corp", JobTitle: "Engineer",
+			StartDate: time.Date(2016, 2, 1, 0, 0, 0, 0, time.UTC), StartDatePrecision: models.DatePrecisionMonth,
+			EndDate: &endDate, EndDatePrecision: models.DatePrecisionMonth,
+			FeatureMaps: []models.FeatureMap{{Value: "Payments"}, {Key: "Impact", Value: "Cut latency"}},
+		}},
+		OtherExperiences: []models.OtherExperience{
+			{Category: "Skills", FeatureMaps: []models.FeatureMap{{Key: "Languages", Value: "Go, SQL"}}},
+			{Category: "Hobbies", FeatureMaps: []models.FeatureMap{{Value: "Climbing"}}},
+		},
+	}
+
+	document := Export(resume)
+	if document.Basics.Email != "jane@example.com" || document.Basics.Phone != "+1 555 0100" {
+		t.Errorf("Expected contacts mapped by key, got %+v", document.Basics)
+	}
+	expectedProfiles := []Profile{{Network: "LinkedIn", URL: "https://linkedin.com/in/jane"}, {Network: "GitHub", Username: "janedoe"}}
+	if !reflect.DeepEqual(document.Basics.Profiles, expectedProfiles) {
+		t.Errorf("Unexpected profiles: %+v", document.Basics.Profiles)
+	}
+
+	work := document.Work[0]
+	if work.StartDate != "2016-02" || work.EndDate != "2021-06" {
+		t.Errorf("Expected dates at month precision, got %s - %s", work.StartDate, work.EndDate)
+	}
+	if !reflect.DeepEqual(work.Highlights, []string{"Payments", "Impact: Cut latency"}) {
+		t.Errorf("Unexpected highlights: %v", work.Highlights)
+	}
+
+	expectedSkills := []Skill{{Name: "Languages", Keywords: []string{"Go", "SQL"}}, {Name: "Hobbies", Keywords: []string{"Climbing"}}}
+	if !reflect.DeepEqual(document.Skills, expectedSkills) {
+		t.Errorf("Unexpected skills: %+v", document.Skills)
+	}
+}
@@ -0,0 +1,87 @@
+package latex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrNoCompiler is returned by Compile when no LaTeX compiler is installed
+var ErrNoCompiler = errors.New("no LaTeX compiler found: install tectonic or pdflatex (e.g. from TeX Live) and make sure it is on the PATH of the server, or download the .tex source and compile it elsewhere")
+
+// compilers are the compilers Compile looks for, in order of preference
+var compilers = []string{"tectonic", "pdflatex"}
+
+// Compiler returns the path of the installed LaTeX compiler, or ErrNoCompiler
+func Compiler() (string, error) {
+	for _, name := range compilers {
+		if path, err := exec.LookPath(name); err == nil {
+			return path, nil
+		}
+	}
+	return "", ErrNoCompiler
+}
+
+// Compile compiles the LaTeX document to PDF in a temporary directory. The compilers run without
+// shell escape and may only read and write files below the working directory, since templates are
+// written by users. Compilation errors include the end of the compiler's log.
+func Compile(ctx context.Context, source string, timeout time.Duration) ([]byte, error) {
+	compiler, err := Compiler()
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "resume-latex-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create build directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "document.tex"), []byte(source), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write document: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	name := filepath.Base(compiler)
+	var cmd *exec.Cmd
+	if name == "tectonic" {
+		cmd = exec.CommandContext(ctx, compiler, "--untrusted", "--chatter", "minimal", "document.tex")
+	} else {
+		cmd = exec.CommandContext(ctx, compiler, "-interaction=nonstopmode", "-halt-on-error", "-no-shell-escape", "document.tex")
+	}
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "openin_any=p", "openout_any=p")
+
+	output, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, fmt.Errorf("%s timed out after %s", name, timeout)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("%s failed: %w\n%s", name, err, logTail(string(output), 20))
+	}
+
+	pdf, err := os.ReadFile(filepath.Join(dir, "document.pdf"))
+	if err != nil {
+		return nil, fmt.Errorf("%s produced no PDF: %w", name, err)
+	}
+	return pdf, nil
+}
+
+// logTail returns the last lines of the compiler output, where LaTeX reports the error that stopped it
+func logTail(output string, lines int) string {
+	all := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if len(all) > lines {
+		all = all[len(all)-lines:]
+	}
+	return strings.Join(all, "\n")
+}
@@ -0,0 +1,110 @@
+// Package latex escapes text for LaTeX, converts Markdown to LaTeX and compiles LaTeX documents
+// to PDF with a locally installed compiler.
+package latex
+
+import (
+	"strings"
+
+	"github.com/rxtech-lab/resume-mcp/internal/markdown"
+)
+
+// Source is LaTeX source that is printed as it is, without escaping
+type Source string
+
+var escaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`$`, `\$`,
+	`&`, `\&`,
+	`#`, `\#`,
+	`%`, `\%`,
+	`_`, `\_`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+	`|`, `\textbar{}`,
+)
+
+// Escape escapes the characters LaTeX treats as commands, so the text is typeset as it is
+func Escape(text string) string {
+	return escaper.Replace(text)
+}
+
+// urlEscaper escapes the characters \href and \url can't take as they are
+var urlEscaper = strings.NewReplacer(
+	`\`, `%5C`,
+	`{`, `%7B`,
+	`}`, `%7D`,
+	`%`, `\%`,
+	`#`, `\#`,
+)
+
+// FromMarkdown converts Markdown to LaTeX. Bold, italic, code and links become \textbf, \textit,
+// \texttt and \href (which needs the hyperref package), and lists become itemize and enumerate.
+// All text is escaped and raw HTML is dropped.
+func FromMarkdown(source string) Source {
+	var out strings.Builder
+	var lists []string
+	closeLists := func(depth int) {
+		for len(lists) > depth {
+			out.WriteString(`\end{` + lists[len(lists)-1] + "}\n")
+			lists = lists[:len(lists)-1]
+		}
+	}
+
+	for _, block := range markdown.Blocks(source) {
+		text := spans(block.Spans)
+		if block.Marker == "" {
+			closeLists(block.Depth)
+			if block.Depth > 0 {
+				out.WriteString(`\par ` + text + "\n")
+				continue
+			}
+			if out.Len() > 0 {
+				out.WriteString("\n")
+			}
+			out.WriteString(text + "\n")
+			continue
+		}
+
+		list := "itemize"
+		if block.Marker != "-" {
+			list = "enumerate"
+		}
+		closeLists(block.Depth)
+		if len(lists) == block.Depth && lists[len(lists)-1] != list {
+			closeLists(block.Depth - 1)
+		}
+		for len(lists) < block.Depth {
+			out.WriteString(`\begin{` + list + "}\n")
+			lists = append(lists, list)
+		}
+		out.WriteString(`\item ` + text + "\n")
+	}
+	closeLists(0)
+	return Source(strings.TrimSuffix(out.String(), "\n"))
+}
+
+// spans returns the formatted text of the spans
+func spans(spans []markdown.Span) string {
+	var out strings.Builder
+	for _, span := range spans {
+		text := strings.ReplaceAll(Escape(span.Text), "\n", `\newline `)
+		if span.Code {
+			text = `\texttt{` + text + `}`
+		}
+		if span.Italic {
+			text = `\textit{` + text + `}`
+		}
+		if span.Bold {
+			text = `\textbf{` + text + `}`
+		}
+		if span.URL != "" {
+			text = `\href{` + urlEscaper.Replace(span.URL) + `}{` + text + `}`
+		}
+		out.WriteString(text)
+	}
+	return out.String()
+}
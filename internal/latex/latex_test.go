@@ -0,0 +1,119 @@
+package latex
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEscape(t *testing.T) {
+	got := Escape(`50% of $10 & #1 {a_b} ~^ \ <x|y>`)
+	want := `50\% of \$10 \& \#1 \{a\_b\} \textasciitilde{}\textasciicircum{} \textbackslash{} \textless{}x\textbar{}y\textgreater{}`
+	if got != want {
+		t.Errorf("Escape() = %q, want %q", got, want)
+	}
+}
+
+func TestFromMarkdown(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "formatting",
+			source: "Cut costs by **40%** with *[caching](https://example.com/a%20b#c)* and `redis_cache`",
+			want:   `Cut costs by \textbf{40\%} with \href{https://example.com/a\%20b\#c}{\textit{caching}} and \texttt{redis\_cache}`,
+		},
+		{
+			name:   "nested lists",
+			source: "Led:\n\n- Payments\n  1. Checkout\n  2. Refunds\n- Search\n\nDone",
+			want: `Led:
+\begin{itemize}
+\item Payments
+\begin{enumerate}
+\item Checkout
+\item Refunds
+\end{enumerate}
+\item Search
+\end{itemize}
+
+Done`,
+		},
+		{
+			name:   "list type changes",
+			source: "1. First\n\n- Bullet",
+			want: `\begin{enumerate}
+\item First
+\end{enumerate}
+\begin{itemize}
+\item Bullet
+\end{itemize}`,
+		},
+		{
+			name:   "html is dropped",
+			source: `Uses <b>bold</b> and \input{/etc/passwd}`,
+			want:   `Uses bold and \textbackslash{}input\{/etc/passwd\}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := string(FromMarkdown(test.source)); got != test.want {
+				t.Errorf("FromMarkdown() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCompile_NoCompiler(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := Compile(context.Background(), `\documentclass{article}`, time.Minute)
+	if !errors.Is(err, ErrNoCompiler) {
+		t.Fatalf("Expected ErrNoCompiler, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "tectonic or pdflatex") {
+		t.Errorf("Expected the error to name the compilers, got %q", err)
+	}
+}
+
+// fakeCompiler installs a pdflatex script running the shell commands as the only compiler on the PATH
+func fakeCompiler(t *testing.T, script string) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pdflatex"), []byte("#!/bin/sh\n"+script+"\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+"/bin"+string(os.PathListSeparator)+"/usr/bin")
+}
+
+func TestCompile(t *testing.T) {
+	fakeCompiler(t, `case "$*" in *-no-shell-escape*) ;; *) exit 3 ;; esac
+[ "$openin_any" = p ] || exit 4
+cp document.tex document.pdf`)
+
+	pdf, err := Compile(context.Background(), "source", time.Minute)
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if string(pdf) != "source" {
+		t.Errorf("Expected the compiled document, got %q", pdf)
+	}
+}
+
+func TestCompile_Error(t *testing.T) {
+	fakeCompiler(t, `echo "! Undefined control sequence."; echo "l.3 \foo"; exit 1`)
+
+	_, err := Compile(context.Background(), "source", time.Minute)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if !strings.Contains(err.Error(), "pdflatex failed") || !strings.Contains(err.Error(), "! Undefined control sequence.") {
+		t.Errorf("Expected the error to include the compiler log, got %q", err)
+	}
+}
@@ -0,0 +1,316 @@
+// Package linkedin imports the data export of LinkedIn, a ZIP archive of CSV files requested under
+// "Settings > Data privacy > Get a copy of your data", into a new resume.
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// Files of the export read by Import, the others are ignored
+const (
+	ProfileFile   = "Profile.csv"
+	PositionsFile = "Positions.csv"
+	EducationFile = "Education.csv"
+	SkillsFile    = "Skills.csv"
+	EmailsFile    = "Email Addresses.csv"
+	PhonesFile    = "PhoneNumbers.csv"
+)
+
+// maxFileSize limits the size of a single uncompressed CSV file
+const maxFileSize = 10 << 20
+
+// ErrNoLinkedInData is returned for archives without any of the files read by Import
+var ErrNoLinkedInData = errors.New("the archive contains none of Profile.csv, Positions.csv, Education.csv or Skills.csv, is it a LinkedIn data export?")
+
+// Import builds a resume from the archive of a LinkedIn data export. Rows that can't be mapped,
+// e.g. positions without a company or with an unreadable date, are left out and described by
+// the returned messages.
+func Import(archive []byte) (*models.Resume, []string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid ZIP archive: %w", err)
+	}
+
+	files := map[string]*zip.File{}
+	for _, file := range reader.File {
+		files[strings.ToLower(path.Base(file.Name))] = file
+	}
+
+	tables := map[string][]row{}
+	for _, name := range []string{ProfileFile, PositionsFile, EducationFile, SkillsFile, EmailsFile, PhonesFile} {
+		file, ok := files[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+		rows, err := readCSV(file)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", name, err)
+		}
+		tables[name] = rows
+	}
+	if tables[ProfileFile] == nil && tables[PositionsFile] == nil && tables[EducationFile] == nil && tables[SkillsFile] == nil {
+		return nil, nil, ErrNoLinkedInData
+	}
+
+	importer := &importer{resume: &models.Resume{}}
+	importer.profile(tables[ProfileFile])
+	importer.contacts(EmailsFile, tables[EmailsFile], "Email Address", "Email")
+	importer.contacts(PhonesFile, tables[PhonesFile], "Number", "Phone")
+	importer.positions(tables[PositionsFile])
+	importer.educations(tables[EducationFile])
+	importer.skills(tables[SkillsFile])
+	return importer.resume, importer.skipped, nil
+}
+
+// row is a CSV record by column name, it keeps its number for messages about it
+type row struct {
+	number int
+	values map[string]string
+}
+
+func (r row) get(column string) string {
+	return strings.TrimSpace(r.values[column])
+}
+
+func readCSV(file *zip.File) ([]row, error) {
+	if file.UncompressedSize64 > maxFileSize {
+		return nil, fmt.Errorf("file is larger than %d MB", maxFileSize>>20)
+	}
+	opened, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer opened.Close()
+
+	data, err := io.ReadAll(io.LimitReader(opened, maxFileSize))
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return []row{}, nil
+	}
+
+	header := records[0]
+	rows := make([]row, 0, len(records)-1)
+	for i, record := range records[1:] {
+		values := map[string]string{}
+		for j, column := range header {
+			if j < len(record) {
+				values[strings.TrimSpace(column)] = record[j]
+			}
+		}
+		rows = append(rows, row{number: i + 1, values: values})
+	}
+	return rows, nil
+}
+
+type importer struct {
+	resume  *models.Resume
+	skipped []string
+}
+
+func (im *importer) skip(file string, r row, format string, args ...any) {
+	im.skipped = append(im.skipped, fmt.Sprintf("%s row %d: %s", file, r.number, fmt.Sprintf(format, args...)))
+}
+
+func (im *importer) addContact(key, value string) {
+	if value != "" {
+		im.resume.Contacts = append(im.resume.Contacts, models.Contact{Key: key, Value: value})
+	}
+}
+
+func (im *importer) profile(rows []row) {
+	for i, r := range rows {
+		if i > 0 {
+			im.skip(ProfileFile, r, "only the first profile is imported")
+			continue
+		}
+		im.resume.Name = strings.TrimSpace(r.get("First Name") + " " + r.get("Last Name"))
+		im.resume.Description = r.get("Summary")
+		im.addContact("Headline", r.get("Headline"))
+		im.addContact("Address", r.get("Address"))
+		im.addContact("Location", r.get("Geo Location"))
+		for _, website := range bracketList(r.get("Websites")) {
+			// websites are listed as TYPE:url, e.g. PERSONAL:https://example.com
+			key, url := "Website", website
+			if kind, rest, ok := strings.Cut(website, ":"); ok && !strings.HasPrefix(rest, "//") {
+				key, url = websiteKey(kind), rest
+			}
+			im.addContact(key, url)
+		}
+		for _, handle := range bracketList(r.get("Twitter Handles")) {
+			im.addContact("Twitter", handle)
+		}
+	}
+}
+
+// contacts adds a contact with the key for the value in the column of every row
+func (im *importer) contacts(file string, rows []row, column, key string) {
+	for _, r := range rows {
+		value := r.get(column)
+		if value == "" {
+			im.skip(file, r, "missing %s", strings.ToLower(column))
+			continue
+		}
+		im.addContact(key, value)
+	}
+}
+
+func (im *importer) positions(rows []row) {
+	for _, r := range rows {
+		experience := models.WorkExperience{
+			Company:  r.get("Company Name"),
+			JobTitle: r.get("Title"),
+			Type:     "fulltime",
+		}
+		if experience.Company == "" || experience.JobTitle == "" {
+			im.skip(PositionsFile, r, "missing company name or title")
+			continue
+		}
+		if err := parseDates(r.get("Started On"), r.get("Finished On"), &experience.StartDate, &experience.StartDatePrecision, &experience.EndDate, &experience.EndDatePrecision); err != nil {
+			im.skip(PositionsFile, r, "%s at %s: %v", experience.JobTitle, experience.Company, err)
+			continue
+		}
+		experience.FeatureMaps = featureMaps(
+			models.FeatureMap{Key: "Description", Value: r.get("Description"), Category: "description"},
+			models.FeatureMap{Key: "Location", Value: r.get("Location"), Category: "location"},
+		)
+		im.resume.WorkExperiences = append(im.resume.WorkExperiences, experience)
+	}
+}
+
+func (im *importer) educations(rows []row) {
+	for _, r := range rows {
+		education := models.Education{
+			SchoolName: r.get("School Name"),
+			Type:       "fulltime",
+			Category:   r.get("Degree Name"),
+		}
+		if education.SchoolName == "" {
+			im.skip(EducationFile, r, "missing school name")
+			continue
+		}
+		if err := parseDates(r.get("Start Date"), r.get("End Date"), &education.StartDate, &education.StartDatePrecision, &education.EndDate, &education.EndDatePrecision); err != nil {
+			im.skip(EducationFile, r, "%s: %v", education.SchoolName, err)
+			continue
+		}
+		education.FeatureMaps = featureMaps(
+			models.FeatureMap{Key: "Notes", Value: r.get("Notes"), Category: "description"},
+			models.FeatureMap{Key: "Activities", Value: r.get("Activities")},
+		)
+		im.resume.Educations = append(im.resume.Educations, education)
+	}
+}
+
+func (im *importer) skills(rows []row) {
+	skills := models.OtherExperience{Category: "Skills"}
+	for _, r := range rows {
+		name := r.get("Name")
+		if name == "" {
+			im.skip(SkillsFile, r, "missing skill name")
+			continue
+		}
+		skills.FeatureMaps = append(skills.FeatureMaps, models.FeatureMap{Value: name})
+	}
+	if len(skills.FeatureMaps) > 0 {
+		im.resume.OtherExperiences = append(im.resume.OtherExperiences, skills)
+	}
+}
+
+// dateLayouts are the date formats of the export, months are written as "Mar 2019"
+var dateLayouts = []struct {
+	layout    string
+	precision string
+}{
+	{"Jan 2006", models.DatePrecisionMonth},
+	{"January 2006", models.DatePrecisionMonth},
+	{"01/2006", models.DatePrecisionMonth},
+	{"2006-01", models.DatePrecisionMonth},
+	{"2006", models.DatePrecisionYear},
+	{"01/02/2006", models.DatePrecisionDay},
+	{"2006-01-02", models.DatePrecisionDay},
+}
+
+func parseDate(value string) (models.PartialDate, error) {
+	for _, format := range dateLayouts {
+		if t, err := time.Parse(format.layout, value); err == nil {
+			return models.PartialDate{Time: t, Precision: format.precision}, nil
+		}
+	}
+	return models.PartialDate{}, fmt.Errorf("unknown date %q", value)
+}
+
+// parseDates parses the start and end date of a row, a missing end date marks an ongoing entry
+func parseDates(start, end string, startDate *time.Time, startPrecision *string, endDate **time.Time, endPrecision *string) error {
+	if start != "" {
+		date, err := parseDate(start)
+		if err != nil {
+			return err
+		}
+		*startDate, *startPrecision = date.Time, date.Precision
+	}
+	if end != "" {
+		date, err := parseDate(end)
+		if err != nil {
+			return err
+		}
+		*endDate, *endPrecision = &date.Time, date.Precision
+	}
+	return nil
+}
+
+// featureMaps returns the feature maps with a value
+func featureMaps(maps ...models.FeatureMap) []models.FeatureMap {
+	var result []models.FeatureMap
+	for _, featureMap := range maps {
+		if featureMap.Value != "" {
+			result = append(result, featureMap)
+		}
+	}
+	return result
+}
+
+// bracketList splits the lists of the profile, written as [a,b]
+func bracketList(value string) []string {
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func websiteKey(kind string) string {
+	switch strings.ToUpper(kind) {
+	case "PERSONAL":
+		return "Website"
+	case "COMPANY":
+		return "Company Website"
+	case "BLOG":
+		return "Blog"
+	case "PORTFOLIO":
+		return "Portfolio"
+	case "RSS":
+		return "RSS"
+	}
+	return "Website"
+}
@@ -0,0 +1,117 @@
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// testArchive returns a ZIP archive of the files, named by their path in the archive
+func testArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for name, content := range files {
+		file, err := writer.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := file.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+var testFiles = map[string]string{
+	"Basic_LinkedInDataExport/Profile.csv": "\ufeffFirst Name,Last Name,Maiden Name,Address,Birth Date,Headline,Summary,Industry,Zip Code,Geo Location,Twitter Handles,Websites,Instant Messengers\n" +
+		`Jane,Doe,,,,Backend Engineer,"Builds payment systems.` + "\n" + `Likes Go.",Software,,"Berlin, Germany",[janedoe],"[PERSONAL:https://jane.example,https://blog.jane.example]",` + "\n",
+	"Positions.csv": "Company Name,Title,Description,Location,Started On,Finished On\n" +
+		`Fintech Inc,Staff Engineer,"- Led payments` + "\n" + `- Cut latency",Berlin,Jul 2021,` + "\n" +
+		"Bank Corp,Engineer,,,2016,Jun 2021\n" +
+		",Intern,,,Jan 2015,Mar 2015\n" +
+		"Startup,Founder,,,sometime,\n",
+	"Education.csv": "School Name,Start Date,End Date,Notes,Degree Name,Activities\n" +
+		"TU Berlin,2012,2016,Thesis on compilers,Master of Science,Chess club\n" +
+		",2010,2012,,,\n",
+	"Skills.csv":          "Name\nGo\nPostgreSQL\n\"\"\n",
+	"Email Addresses.csv": "Email Address,Confirmed,Primary,Updated On\njane@example.com,Yes,Yes,\n",
+	"Connections.csv":     "Notes:\nignored\n",
+}
+
+func TestImport(t *testing.T) {
+	resume, skipped, err := Import(testArchive(t, testFiles))
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	if resume.Name != "Jane Doe" || resume.Description != "Builds payment systems.\nLikes Go." {
+		t.Errorf("Unexpected basic info: %q, %q", resume.Name, resume.Description)
+	}
+
+	expectedContacts := []models.Contact{
+		{Key: "Headline", Value: "Backend Engineer"},
+		{Key: "Location", Value: "Berlin, Germany"},
+		{Key: "Website", Value: "https://jane.example"},
+		{Key: "Website", Value: "https://blog.jane.example"},
+		{Key: "Twitter", Value: "janedoe"},
+		{Key: "Email", Value: "jane@example.com"},
+	}
+	if !reflect.DeepEqual(resume.Contacts, expectedContacts) {
+		t.Errorf("Unexpected contacts: %+v", resume.Contacts)
+	}
+
+	if len(resume.WorkExperiences) != 2 {
+		t.Fatalf("Expected 2 work experiences, got %+v", resume.WorkExperiences)
+	}
+	current := resume.WorkExperiences[0]
+	if current.Company != "Fintech Inc" || current.JobTitle != "Staff Engineer" || !current.Current() || current.Start().String() != "2021-07" {
+		t.Errorf("Unexpected work experience: %+v", current)
+	}
+	if len(current.FeatureMaps) != 2 || current.FeatureMaps[0].Value != "- Led payments\n- Cut latency" || current.FeatureMaps[1].Value != "Berlin" {
+		t.Errorf("Unexpected feature maps: %+v", current.FeatureMaps)
+	}
+	previous := resume.WorkExperiences[1]
+	if previous.Start().String() != "2016" || previous.End().String() != "2021-06" || len(previous.FeatureMaps) != 0 {
+		t.Errorf("Unexpected work experience: %+v", previous)
+	}
+
+	if len(resume.Educations) != 1 || resume.Educations[0].SchoolName != "TU Berlin" || resume.Educations[0].Category != "Master of Science" || resume.Educations[0].End().String() != "2016" {
+		t.Errorf("Unexpected educations: %+v", resume.Educations)
+	}
+	if len(resume.OtherExperiences) != 1 || len(resume.OtherExperiences[0].FeatureMaps) != 2 || resume.OtherExperiences[0].FeatureMaps[1].Value != "PostgreSQL" {
+		t.Errorf("Unexpected skills: %+v", resume.OtherExperiences)
+	}
+
+	expectedSkipped := []string{
+		"Positions.csv row 3: missing company name or title",
+		`Positions.csv row 4: Founder at Startup: unknown date "sometime"`,
+		"Education.csv row 2: missing school name",
+		"Skills.csv row 3: missing skill name",
+	}
+	if !reflect.DeepEqual(skipped, expectedSkipped) {
+		t.Errorf("Unexpected skipped rows:\n%q", skipped)
+	}
+}
+
+func TestImport_Errors(t *testing.T) {
+	if _, _, err := Import([]byte("not a zip")); err == nil {
+		t.Error("Expected error for an invalid archive")
+	}
+
+	_, _, err := Import(testArchive(t, map[string]string{"Connections.csv": "First Name\nJohn\n"}))
+	if !errors.Is(err, ErrNoLinkedInData) {
+		t.Errorf("Expected ErrNoLinkedInData, got %v", err)
+	}
+
+	if _, _, err := Import(testArchive(t, map[string]string{"Positions.csv": "Company Name,Title\n\"unterminated\n"})); err == nil {
+		t.Error("Expected error for an invalid CSV file")
+	}
+}
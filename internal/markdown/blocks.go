@@ -0,0 +1,218 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// Block is a paragraph or a list item of Markdown, for writers of formats other than HTML
+type Block struct {
+	// Depth is the list nesting of the block, 0 for paragraphs outside of lists
+	Depth int
+	// Marker is "-" for bullet list items, the number followed by a dot for ordered list items
+	// and empty for paragraphs. Paragraphs inside list items continue the item and have no marker.
+	Marker string
+	Spans  []Span
+}
+
+// Span is a run of text with the same formatting
+type Span struct {
+	Text   string
+	Bold   bool
+	Italic bool
+	Code   bool
+	// URL is the destination of links
+	URL string
+}
+
+// Text returns the text of the block's spans
+func (b Block) Text() string {
+	var out strings.Builder
+	for _, span := range b.Spans {
+		out.WriteString(span.Text)
+	}
+	return out.String()
+}
+
+// Blocks splits Markdown into paragraphs and list items with their formatting. Headings become bold
+// paragraphs, table rows paragraphs with cells separated by " | ", and raw HTML is dropped.
+func Blocks(source string) []Block {
+	src := []byte(source)
+	document := converter.Parser().Parse(text.NewReader(src))
+
+	w := &blockWriter{source: src}
+	w.blocks(document, 0)
+	return w.out
+}
+
+type blockWriter struct {
+	source []byte
+	out    []Block
+}
+
+func (w *blockWriter) blocks(node ast.Node, depth int) {
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		switch n := child.(type) {
+		case *ast.List:
+			number := n.Start
+			for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+				marker := "-"
+				if n.IsOrdered() {
+					marker = fmt.Sprintf("%d.", number)
+					number++
+				}
+				w.listItem(item, depth+1, marker)
+			}
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+			lines := child.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				segment := lines.At(i)
+				line := strings.TrimRight(string(segment.Value(w.source)), "\n")
+				w.add(Block{Depth: depth, Spans: []Span{{Text: line, Code: true}}})
+			}
+		case *ast.HTMLBlock, *ast.ThematicBreak:
+			// Raw HTML and rules have no text
+		case *extast.Table:
+			for row := n.FirstChild(); row != nil; row = row.NextSibling() {
+				var spans []Span
+				for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+					if len(spans) > 0 {
+						spans = append(spans, Span{Text: " | "})
+					}
+					spans = append(spans, w.spans(cell, Span{})...)
+				}
+				w.add(Block{Depth: depth, Spans: spans})
+			}
+		case *ast.Heading:
+			w.add(Block{Depth: depth, Spans: w.spans(child, Span{Bold: true})})
+		case *ast.Paragraph, *ast.TextBlock:
+			w.add(Block{Depth: depth, Spans: w.spans(child, Span{})})
+		default:
+			w.blocks(child, depth)
+		}
+	}
+}
+
+// listItem adds the item's first paragraph with the marker and its other blocks, like nested lists, after it
+func (w *blockWriter) listItem(item ast.Node, depth int, marker string) {
+	first := item.FirstChild()
+	block := Block{Depth: depth, Marker: marker}
+	switch first.(type) {
+	case *ast.Paragraph, *ast.TextBlock:
+		block.Spans = w.spans(first, Span{})
+	default:
+		first = nil
+	}
+	w.out = append(w.out, block)
+
+	rest := &ast.Document{}
+	for child := item.FirstChild(); child != nil; {
+		next := child.NextSibling()
+		if child != first {
+			rest.AppendChild(rest, child)
+		}
+		child = next
+	}
+	w.blocks(rest, depth)
+}
+
+func (w *blockWriter) add(block Block) {
+	if len(block.Spans) > 0 {
+		w.out = append(w.out, block)
+	}
+}
+
+// spans returns the formatted text of the node's inline children, inheriting the formatting of style
+func (w *blockWriter) spans(node ast.Node, style Span) []Span {
+	var spans []Span
+	appendText := func(text string, style Span) {
+		if text == "" {
+			return
+		}
+		last := len(spans) - 1
+		if last >= 0 && spans[last].Bold == style.Bold && spans[last].Italic == style.Italic && spans[last].Code == style.Code && spans[last].URL == style.URL {
+			spans[last].Text += text
+			return
+		}
+		style.Text = text
+		spans = append(spans, style)
+	}
+
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		switch n := child.(type) {
+		case *ast.Text:
+			text := string(n.Segment.Value(w.source))
+			if n.HardLineBreak() {
+				text += "\n"
+			} else if n.SoftLineBreak() {
+				text += " "
+			}
+			appendText(text, style)
+		case *ast.String:
+			appendText(string(n.Value), style)
+		case *ast.CodeSpan:
+			code := style
+			code.Code = true
+			for _, span := range w.spans(n, code) {
+				appendText(span.Text, span)
+			}
+		case *ast.Emphasis:
+			emphasis := style
+			if n.Level == 2 {
+				emphasis.Bold = true
+			} else {
+				emphasis.Italic = true
+			}
+			for _, span := range w.spans(n, emphasis) {
+				appendText(span.Text, span)
+			}
+		case *ast.AutoLink:
+			link := style
+			link.URL = safeURL(string(n.URL(w.source)))
+			appendText(string(n.Label(w.source)), link)
+		case *ast.Link:
+			link := style
+			link.URL = safeURL(string(n.Destination))
+			linkSpans := w.spans(n, link)
+			if len(linkSpans) == 0 {
+				appendText(string(n.Destination), link)
+			}
+			for _, span := range linkSpans {
+				appendText(span.Text, span)
+			}
+		case *ast.RawHTML:
+			// Inline HTML tags have no text
+		default:
+			for _, span := range w.spans(child, style) {
+				appendText(span.Text, span)
+			}
+		}
+	}
+
+	if len(spans) > 0 {
+		spans[0].Text = strings.TrimLeft(spans[0].Text, " ")
+		last := len(spans) - 1
+		spans[last].Text = strings.TrimRight(spans[last].Text, " \n")
+		if spans[last].Text == "" {
+			spans = spans[:last]
+		}
+	}
+	return spans
+}
+
+// safeURL returns the URL unless it has a scheme other than http, https, mailto or tel, like javascript:
+func safeURL(url string) string {
+	scheme, _, found := strings.Cut(url, ":")
+	if !found || strings.ContainsAny(scheme, "/?#") {
+		return url
+	}
+	switch strings.ToLower(scheme) {
+	case "http", "https", "mailto", "tel":
+		return url
+	}
+	return ""
+}
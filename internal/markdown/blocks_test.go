@@ -0,0 +1,68 @@
+package markdown
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBlocks(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []Block
+	}{
+		{
+			name:   "formatting",
+			source: "Cut latency by **40%** with *[caching](https://example.com/post)* and `redis`",
+			want: []Block{{Spans: []Span{
+				{Text: "Cut latency by "},
+				{Text: "40%", Bold: true},
+				{Text: " with "},
+				{Text: "caching", Italic: true, URL: "https://example.com/post"},
+				{Text: " and "},
+				{Text: "redis", Code: true},
+			}}},
+		},
+		{
+			name:   "nested lists",
+			source: "Led:\n\n- Payments\n  1. Checkout\n  2. Refunds\n- Search",
+			want: []Block{
+				{Spans: []Span{{Text: "Led:"}}},
+				{Depth: 1, Marker: "-", Spans: []Span{{Text: "Payments"}}},
+				{Depth: 2, Marker: "1.", Spans: []Span{{Text: "Checkout"}}},
+				{Depth: 2, Marker: "2.", Spans: []Span{{Text: "Refunds"}}},
+				{Depth: 1, Marker: "-", Spans: []Span{{Text: "Search"}}},
+			},
+		},
+		{
+			name:   "headings and html",
+			source: "## Impact\n\n<div>raw</div>\n\nSaved <b>time</b>",
+			want: []Block{
+				{Spans: []Span{{Text: "Impact", Bold: true}}},
+				{Spans: []Span{{Text: "Saved time"}}},
+			},
+		},
+		{
+			name:   "dangerous links dropped",
+			source: "[click](javascript:alert(1)) <https://example.com>",
+			want: []Block{{Spans: []Span{
+				{Text: "click "},
+				{Text: "https://example.com", URL: "https://example.com"},
+			}}},
+		},
+		{
+			name:   "empty",
+			source: "",
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Blocks(tt.source)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Blocks() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Package markdown converts the Markdown agents write into feature map values and descriptions
+// to HTML for templates and to plain text for text-only formats.
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// converter renders GitHub flavored Markdown. It's left in its default safe mode, which omits raw
+// HTML and drops links with dangerous URLs such as javascript:, so its output can be embedded as is.
+var converter = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+// ToHTML converts Markdown to HTML that is safe to embed in a page
+func ToHTML(source string) (template.HTML, error) {
+	var out bytes.Buffer
+	if err := converter.Convert([]byte(source), &out); err != nil {
+		return "", fmt.Errorf("failed to convert markdown: %w", err)
+	}
+	return template.HTML(out.String()), nil
+}
+
+// ToText converts Markdown to plain text. Formatting is dropped, list items keep a "- " or "1. "
+// marker indented by their nesting, and links are followed by their URL in parentheses.
+func ToText(source string) string {
+	src := []byte(source)
+	document := converter.Parser().Parse(text.NewReader(src))
+
+	w := &textWriter{source: src}
+	w.blocks(document, 0)
+	return strings.TrimSpace(w.out.String())
+}
+
+type textWriter struct {
+	source []byte
+	out    strings.Builder
+}
+
+// blocks writes the block children of the node, one per line, indented by the list depth
+func (w *textWriter) blocks(node ast.Node, depth int) {
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		switch n := child.(type) {
+		case *ast.List:
+			number := n.Start
+			for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+				marker := "- "
+				if n.IsOrdered() {
+					marker = fmt.Sprintf("%d. ", number)
+					number++
+				}
+				w.out.WriteString(strings.Repeat("  ", depth) + marker)
+				w.listItem(item, depth)
+			}
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+			lines := child.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				segment := lines.At(i)
+				w.out.WriteString(strings.Repeat("  ", depth))
+				w.out.Write(segment.Value(w.source))
+			}
+		case *ast.HTMLBlock, *ast.ThematicBreak:
+			// Raw HTML and rules have no text
+		case *extast.Table:
+			for row := n.FirstChild(); row != nil; row = row.NextSibling() {
+				var cells []string
+				for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+					cells = append(cells, w.inlineText(cell))
+				}
+				w.line(depth, strings.Join(cells, " | "))
+			}
+		case *ast.Paragraph, *ast.TextBlock, *ast.Heading:
+			w.line(depth, w.inlineText(child))
+		default:
+			w.blocks(child, depth)
+		}
+	}
+}
+
+// listItem writes the item's first paragraph after its marker and its other blocks, like nested lists, below it
+func (w *textWriter) listItem(item ast.Node, depth int) {
+	first := item.FirstChild()
+	if first == nil {
+		w.out.WriteString("\n")
+		return
+	}
+	switch first.(type) {
+	case *ast.Paragraph, *ast.TextBlock:
+		w.out.WriteString(w.inlineText(first) + "\n")
+	default:
+		w.out.WriteString("\n")
+		first = nil
+	}
+
+	rest := &ast.Document{}
+	for child := item.FirstChild(); child != nil; {
+		next := child.NextSibling()
+		if child != first {
+			rest.AppendChild(rest, child)
+		}
+		child = next
+	}
+	w.blocks(rest, depth+1)
+}
+
+func (w *textWriter) line(depth int, text string) {
+	if text == "" {
+		return
+	}
+	w.out.WriteString(strings.Repeat("  ", depth) + text + "\n")
+}
+
+// inlineText returns the text of the node's inline children
+func (w *textWriter) inlineText(node ast.Node) string {
+	var out strings.Builder
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		switch n := child.(type) {
+		case *ast.Text:
+			out.Write(n.Segment.Value(w.source))
+			if n.HardLineBreak() {
+				out.WriteString("\n")
+			} else if n.SoftLineBreak() {
+				out.WriteString(" ")
+			}
+		case *ast.String:
+			out.Write(n.Value)
+		case *ast.AutoLink:
+			out.Write(n.URL(w.source))
+		case *ast.Link:
+			label := w.inlineText(n)
+			destination := string(n.Destination)
+			if label == "" {
+				out.WriteString(destination)
+			} else if destination == "" || destination == label {
+				out.WriteString(label)
+			} else {
+				out.WriteString(fmt.Sprintf("%s (%s)", label, destination))
+			}
+		case *ast.RawHTML:
+			// Inline HTML tags have no text
+		default:
+			out.WriteString(w.inlineText(child))
+		}
+	}
+	return strings.TrimSpace(out.String())
+}
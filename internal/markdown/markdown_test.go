@@ -0,0 +1,81 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "formatting",
+			source:   "Cut latency by **40%** with [caching](https://example.com/post)",
+			contains: []string{"<strong>40%</strong>", `<a href="https://example.com/post">caching</a>`},
+		},
+		{
+			name:     "nested bullets",
+			source:   "- Payments\n  - Checkout\n- Search",
+			contains: []string{"<ul>\n<li>Payments\n<ul>\n<li>Checkout</li>", "<li>Search</li>"},
+		},
+		{
+			name:     "raw html omitted",
+			source:   "Hello <script>alert(1)</script>\n\n<div onclick=\"x()\">block</div>",
+			excludes: []string{"<script>", "onclick"},
+		},
+		{
+			name:     "dangerous links dropped",
+			source:   "[click](javascript:alert(1))",
+			excludes: []string{"javascript:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, err := ToHTML(tt.source)
+			if err != nil {
+				t.Fatalf("ToHTML() error = %v", err)
+			}
+			for _, expected := range tt.contains {
+				if !strings.Contains(string(html), expected) {
+					t.Errorf("Expected %q in output, got %s", expected, html)
+				}
+			}
+			for _, unexpected := range tt.excludes {
+				if strings.Contains(string(html), unexpected) {
+					t.Errorf("Expected no %q in output, got %s", unexpected, html)
+				}
+			}
+		})
+	}
+}
+
+func TestToText(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{"plain", "Led a team of 6 engineers", "Led a team of 6 engineers"},
+		{"formatting", "Cut latency by **40%** using _caching_ and `redis`", "Cut latency by 40% using caching and redis"},
+		{"link", "See [my blog](https://example.com)", "See my blog (https://example.com)"},
+		{"autolink", "<https://example.com>", "https://example.com"},
+		{"nested bullets", "- Payments\n  - Checkout\n  - Refunds\n- Search", "- Payments\n  - Checkout\n  - Refunds\n- Search"},
+		{"ordered list", "3. Third\n4. Fourth", "3. Third\n4. Fourth"},
+		{"paragraphs", "# Summary\n\nFirst line\nwrapped\n\nSecond paragraph", "Summary\nFirst line wrapped\nSecond paragraph"},
+		{"raw html", "Hello <b>world</b>\n\n<div>block</div>", "Hello world"},
+		{"table", "| Skill | Years |\n| --- | --- |\n| Go | 5 |", "Skill | Years\nGo | 5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToText(tt.source); got != tt.expected {
+				t.Errorf("ToText() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package mcp
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/events"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+	"github.com/rxtech-lab/resume-mcp/resources"
+)
+
+// changePublisher publishes change events after successful calls to tools that modify data
+type changePublisher struct {
+	db     *database.Database
+	broker *events.Broker
+}
+
+func (p *changePublisher) toolMiddleware() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if slices.Contains(readOnlyTools, request.Params.Name) {
+				return next(ctx, request)
+			}
+
+			// Resolve before running the tool, deleted entities can't be looked up afterwards
+			changes := p.resolveChanges(ctx, request)
+
+			result, err := next(ctx, request)
+			if err != nil || result == nil || result.IsError {
+				return result, err
+			}
+
+			for _, change := range changes {
+				p.broker.Publish(change)
+			}
+			return result, err
+		}
+	}
+}
+
+// resolveChanges works out which resumes, templates and preview sessions a tool call affects
+func (p *changePublisher) resolveChanges(ctx context.Context, request mcp.CallToolRequest) []events.ChangeEvent {
+	var userID *string
+	event := events.ChangeEvent{}
+	if user := authenticatedUser(ctx); user != nil {
+		userID = &user.Sub
+		event.UserID = user.Sub
+	}
+
+	if id, ok := uintArgument(request, "resume_id"); ok {
+		event.ResumeID = id
+		return []events.ChangeEvent{event}
+	}
+
+	if id, ok := uintArgument(request, "template_id"); ok {
+		template, err := p.db.GetTemplateByID(id, userID)
+		if err != nil {
+			return nil
+		}
+		event.TemplateID = template.ID
+		// User templates aren't tied to a resume, so only the template changes
+		if template.ResumeID != nil {
+			event.ResumeID = *template.ResumeID
+		}
+		return []events.ChangeEvent{event}
+	}
+
+	if id, ok := uintArgument(request, "cover_letter_id"); ok {
+		letter, err := p.db.GetCoverLetterByID(id, userID)
+		if err != nil {
+			return nil
+		}
+		event.ResumeID = letter.ResumeID
+		return []events.ChangeEvent{event}
+	}
+
+	if sessionID := request.GetString("session_id", ""); sessionID != "" {
+		session, err := p.db.GetPreviewSession(sessionID, nil)
+		if err != nil {
+			return nil
+		}
+		event.SessionID = session.ID
+		event.ResumeID = session.ResumeID
+		return []events.ChangeEvent{event}
+	}
+
+	if name, ok := p.partialName(request, userID); ok {
+		return p.partialChanges(request, event, name, userID)
+	}
+
+	experienceID, ok := uintArgument(request, "experience_id")
+	if featureMapID, isFeatureMap := uintArgument(request, "feature_map_id"); isFeatureMap {
+		featureMap, err := p.db.GetFeatureMapByID(featureMapID, userID)
+		if err != nil {
+			return nil
+		}
+		experienceID, ok = featureMap.ExperienceID, true
+	}
+	if !ok {
+		return nil
+	}
+
+	resumeIDs, err := p.db.ResumeIDsForExperience(experienceID, userID)
+	if err != nil {
+		return nil
+	}
+	changes := make([]events.ChangeEvent, 0, len(resumeIDs))
+	for _, resumeID := range resumeIDs {
+		event.ResumeID = resumeID
+		changes = append(changes, event)
+	}
+	return changes
+}
+
+// partialName returns the name of the partial a partial tool call creates, updates or deletes
+func (p *changePublisher) partialName(request mcp.CallToolRequest, userID *string) (string, bool) {
+	if request.Params.Name == "create_partial" {
+		name := request.GetString("name", "")
+		return name, name != ""
+	}
+	id, ok := uintArgument(request, "partial_id")
+	if !ok {
+		return "", false
+	}
+	partial, err := p.db.GetPartialByID(id, userID)
+	if err != nil {
+		return "", false
+	}
+	return partial.Name, true
+}
+
+// partialChanges returns a change of every template including the partial, directly or through other partials
+func (p *changePublisher) partialChanges(request mcp.CallToolRequest, event events.ChangeEvent, name string, userID *string) []events.ChangeEvent {
+	partials, err := p.db.PartialSources(userID)
+	if err != nil {
+		return nil
+	}
+	// A created partial isn't stored yet, templates may already include it
+	if _, exists := partials[name]; !exists {
+		partials[name] = request.GetString("template_data", "")
+	}
+	templates, err := p.db.ListTemplatesWithData(userID)
+	if err != nil {
+		return nil
+	}
+
+	var changes []events.ChangeEvent
+	for _, template := range service.TemplatesIncludingPartial(templates, name, partials) {
+		change := event
+		change.TemplateID = template.ID
+		if template.ResumeID != nil {
+			change.ResumeID = *template.ResumeID
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// resourceSubscriptions remembers which client sessions subscribed to which
+// resource URIs and sends them notifications/resources/updated on changes
+type resourceSubscriptions struct {
+	mu sync.Mutex
+	// session ID -> resource URI -> user ID of the subscriber
+	sessions map[string]map[string]string
+}
+
+func newResourceSubscriptions() *resourceSubscriptions {
+	return &resourceSubscriptions{
+		sessions: map[string]map[string]string{},
+	}
+}
+
+func (r *resourceSubscriptions) register(hooks *server.Hooks) {
+	hooks.AddAfterSubscribe(func(ctx context.Context, id any, message *mcp.SubscribeRequest, result *mcp.EmptyResult) {
+		session := server.ClientSessionFromContext(ctx)
+		if session == nil {
+			return
+		}
+		userID := ""
+		if user := authenticatedUser(ctx); user != nil {
+			userID = user.Sub
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.sessions[session.SessionID()] == nil {
+			r.sessions[session.SessionID()] = map[string]string{}
+		}
+		r.sessions[session.SessionID()][message.Params.URI] = userID
+	})
+
+	hooks.AddAfterUnsubscribe(func(ctx context.Context, id any, message *mcp.UnsubscribeRequest, result *mcp.EmptyResult) {
+		session := server.ClientSessionFromContext(ctx)
+		if session == nil {
+			return
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.sessions[session.SessionID()], message.Params.URI)
+	})
+
+	hooks.AddOnUnregisterSession(func(ctx context.Context, session server.ClientSession) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.sessions, session.SessionID())
+	})
+}
+
+// notify sends resource updated notifications for every subscribed URI touched by the event
+func (r *resourceSubscriptions) notify(srv *server.MCPServer, event events.ChangeEvent) {
+	var uris []string
+	if event.ResumeID != 0 {
+		uris = append(uris, resources.ResumeURI(event.ResumeID))
+	}
+	if event.TemplateID != 0 {
+		uris = append(uris, resources.TemplateURI(event.TemplateID))
+	}
+	if event.SessionID != "" {
+		uris = append(uris, resources.PreviewURI(event.SessionID))
+	}
+
+	type notification struct {
+		sessionID string
+		uri       string
+	}
+	var pending []notification
+
+	r.mu.Lock()
+	for sessionID, subscriptions := range r.sessions {
+		for _, uri := range uris {
+			userID, ok := subscriptions[uri]
+			if ok && userID == event.UserID {
+				pending = append(pending, notification{sessionID: sessionID, uri: uri})
+			}
+		}
+	}
+	r.mu.Unlock()
+
+	for _, n := range pending {
+		_ = srv.SendNotificationToSpecificClient(n.sessionID, mcp.MethodNotificationResourceUpdated, map[string]any{
+			"uri": n.uri,
+		})
+	}
+}
+
+// listen forwards broker events to subscribed clients until stop is called
+func (r *resourceSubscriptions) listen(srv *server.MCPServer, broker *events.Broker) (stop func()) {
+	ch, unsubscribe := broker.Subscribe()
+	go func() {
+		for event := range ch {
+			r.notify(srv, event)
+		}
+	}()
+	return unsubscribe
+}
+
+// authenticatedUser returns the user stored in the context, or nil in stdio mode
+func authenticatedUser(ctx context.Context) *types.AuthenticatedUser {
+	user, _ := ctx.Value(types.AuthenticatedUserContextKey).(*types.AuthenticatedUser)
+	return user
+}
+
+func uintArgument(request mcp.CallToolRequest, name string) (uint, bool) {
+	value := request.GetString(name, "")
+	if value == "" {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint(id), true
+}
@@ -0,0 +1,129 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/events"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func newToolRequest(name string, args map[string]any) mcp.CallToolRequest {
+	request := mcp.CallToolRequest{}
+	request.Params.Name = name
+	request.Params.Arguments = args
+	return request
+}
+
+func TestChangePublisher_ToolMiddleware(t *testing.T) {
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		t.Fatalf("Failed to create test database: %v", err)
+	}
+	defer db.Close()
+
+	userID := "test-user"
+	resume := &models.Resume{Name: "Test Resume"}
+	if err := db.CreateResume(resume, &userID); err != nil {
+		t.Fatalf("Failed to create resume: %v", err)
+	}
+	template := &models.Template{ResumeID: &resume.ID, Name: "Default", TemplateData: "<h1>{{.Name}}</h1>"}
+	if err := db.CreateTemplate(template, &userID); err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+
+	if err := db.CreatePartial(&models.Partial{Name: "header", TemplateData: "<h1>{{.Name}}</h1>"}, &userID); err != nil {
+		t.Fatalf("Failed to create partial: %v", err)
+	}
+	shared := &models.Template{Name: "Shared", TemplateData: `{{template "header" .}}`}
+	if err := db.CreateTemplate(shared, &userID); err != nil {
+		t.Fatalf("Failed to create user template: %v", err)
+	}
+	// Templates stored before the partial they include was created
+	footer := &models.Template{ResumeID: &resume.ID, Name: "With footer", TemplateData: `{{template "footer" .}}`}
+	if err := db.CreateTemplate(footer, &userID); err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+
+	broker := events.NewBroker()
+	changes, unsubscribe := broker.Subscribe()
+	defer unsubscribe()
+
+	publisher := &changePublisher{db: db, broker: broker}
+	ctx := types.WithAuthenticatedUser(context.Background(), &types.AuthenticatedUser{Sub: userID})
+
+	succeed := publisher.toolMiddleware()(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	})
+	fail := publisher.toolMiddleware()(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("failed"), nil
+	})
+
+	tests := []struct {
+		name     string
+		handler  func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		request  mcp.CallToolRequest
+		expected *events.ChangeEvent
+	}{
+		{
+			name:     "resume change",
+			handler:  succeed,
+			request:  newToolRequest("add_contact_info", map[string]any{"resume_id": "1"}),
+			expected: &events.ChangeEvent{UserID: userID, ResumeID: resume.ID},
+		},
+		{
+			name:     "template change",
+			handler:  succeed,
+			request:  newToolRequest("update_template", map[string]any{"template_id": "1"}),
+			expected: &events.ChangeEvent{UserID: userID, ResumeID: resume.ID, TemplateID: template.ID},
+		},
+		{
+			name:     "partial change",
+			handler:  succeed,
+			request:  newToolRequest("update_partial", map[string]any{"partial_id": "1"}),
+			expected: &events.ChangeEvent{UserID: userID, TemplateID: shared.ID},
+		},
+		{
+			name:     "partial created",
+			handler:  succeed,
+			request:  newToolRequest("create_partial", map[string]any{"name": "footer", "template_data": "<footer></footer>"}),
+			expected: &events.ChangeEvent{UserID: userID, ResumeID: resume.ID, TemplateID: footer.ID},
+		},
+		{
+			name:    "read-only tool",
+			handler: succeed,
+			request: newToolRequest("get_resume_context", map[string]any{"resume_id": "1"}),
+		},
+		{
+			name:    "failed tool call",
+			handler: fail,
+			request: newToolRequest("add_contact_info", map[string]any{"resume_id": "1"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.handler(ctx, tt.request); err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+
+			select {
+			case event := <-changes:
+				if tt.expected == nil {
+					t.Fatalf("Expected no event, got %+v", event)
+				}
+				if event != *tt.expected {
+					t.Errorf("Expected event %+v, got %+v", *tt.expected, event)
+				}
+			case <-time.After(50 * time.Millisecond):
+				if tt.expected != nil {
+					t.Fatal("Expected a change event")
+				}
+			}
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package mcp
 
 import (
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/events"
 	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/resources"
 	"github.com/rxtech-lab/resume-mcp/tools"
 )
 
@@ -12,86 +15,219 @@ type MCPServer struct {
 	db              *database.Database
 	templateService *service.TemplateService
 	port            string
+	toolConfig      ToolConfig
+	broker          *events.Broker
+	// stopNotifications stops forwarding change events to the current server
+	stopNotifications func()
+	// localFiles lets tools read files by path, which is only wanted for the local stdio server
+	localFiles bool
 }
 
-func NewMCPServer(db *database.Database, port string, templateService *service.TemplateService) *MCPServer {
+func NewMCPServer(db *database.Database, port string, templateService *service.TemplateService, broker *events.Broker) *MCPServer {
 	mcpServer := &MCPServer{
 		db:              db,
 		templateService: templateService,
 		port:            port,
+		broker:          broker,
 	}
 	mcpServer.InitializeTools(db, port, templateService)
 	return mcpServer
 }
 
 func (s *MCPServer) InitializeTools(db *database.Database, port string, templateService *service.TemplateService) {
+	publisher := &changePublisher{db: db, broker: s.broker}
+	subscriptions := newResourceSubscriptions()
+	hooks := &server.Hooks{}
+	subscriptions.register(hooks)
+
 	srv := server.NewMCPServer(
 		"Resume MCP Server",
 		"1.0.0",
 		server.WithToolCapabilities(true),
+		server.WithResourceCapabilities(true, false),
+		server.WithHooks(hooks),
+		server.WithCompletions(),
+		server.WithResourceCompletionProvider(resources.NewCompletionProvider(db)),
+		server.WithToolFilter(s.toolConfig.toolFilter()),
+		server.WithToolHandlerMiddleware(s.toolConfig.toolMiddleware()),
+		server.WithToolHandlerMiddleware(publisher.toolMiddleware()),
 	)
 
+	// Only register the tools allowed by the configured profiles
+	addTool := func(tool mcp.Tool, handler server.ToolHandlerFunc) {
+		if s.toolConfig.Registers(tool.Name) {
+			srv.AddTool(tool, handler)
+		}
+	}
+
 	// Initialize all tools
 	createResumeTool, createResumeHandler := tools.NewCreateResumeTool(db)
-	srv.AddTool(createResumeTool, createResumeHandler)
+	addTool(createResumeTool, createResumeHandler)
 
 	updateBasicInfoTool, updateBasicInfoHandler := tools.NewUpdateBasicInfoTool(db)
-	srv.AddTool(updateBasicInfoTool, updateBasicInfoHandler)
+	addTool(updateBasicInfoTool, updateBasicInfoHandler)
 
 	addContactInfoTool, addContactInfoHandler := tools.NewAddContactInfoTool(db)
-	srv.AddTool(addContactInfoTool, addContactInfoHandler)
+	addTool(addContactInfoTool, addContactInfoHandler)
 
 	addWorkExperienceTool, addWorkExperienceHandler := tools.NewAddWorkExperienceTool(db)
-	srv.AddTool(addWorkExperienceTool, addWorkExperienceHandler)
+	addTool(addWorkExperienceTool, addWorkExperienceHandler)
 
 	addEducationTool, addEducationHandler := tools.NewAddEducationTool(db)
-	srv.AddTool(addEducationTool, addEducationHandler)
+	addTool(addEducationTool, addEducationHandler)
 
 	addOtherExperienceTool, addOtherExperienceHandler := tools.NewAddOtherExperienceTool(db)
-	srv.AddTool(addOtherExperienceTool, addOtherExperienceHandler)
+	addTool(addOtherExperienceTool, addOtherExperienceHandler)
 
 	addFeatureMapTool, addFeatureMapHandler := tools.NewAddFeatureMapTool(db)
-	srv.AddTool(addFeatureMapTool, addFeatureMapHandler)
+	addTool(addFeatureMapTool, addFeatureMapHandler)
 
 	updateFeatureMapTool, updateFeatureMapHandler := tools.NewUpdateFeatureMapTool(db)
-	srv.AddTool(updateFeatureMapTool, updateFeatureMapHandler)
+	addTool(updateFeatureMapTool, updateFeatureMapHandler)
 
 	deleteFeatureMapTool, deleteFeatureMapHandler := tools.NewDeleteFeatureMapTool(db)
-	srv.AddTool(deleteFeatureMapTool, deleteFeatureMapHandler)
+	addTool(deleteFeatureMapTool, deleteFeatureMapHandler)
 
 	getResumeByNameTool, getResumeByNameHandler := tools.NewGetResumeByNameTool(db)
-	srv.AddTool(getResumeByNameTool, getResumeByNameHandler)
+	addTool(getResumeByNameTool, getResumeByNameHandler)
 
 	listResumesTool, listResumesHandler := tools.NewListResumesTool(db)
-	srv.AddTool(listResumesTool, listResumesHandler)
+	addTool(listResumesTool, listResumesHandler)
 
 	deleteResumeTool, deleteResumeHandler := tools.NewDeleteResumeTool(db)
-	srv.AddTool(deleteResumeTool, deleteResumeHandler)
+	addTool(deleteResumeTool, deleteResumeHandler)
 
 	generatePreviewTool, generatePreviewHandler := tools.NewGeneratePreviewTool(db, port, templateService)
-	srv.AddTool(generatePreviewTool, generatePreviewHandler)
+	addTool(generatePreviewTool, generatePreviewHandler)
 
 	updatePreviewStyleTool, updatePreviewStyleHandler := tools.NewUpdatePreviewStyleTool(db, port)
-	srv.AddTool(updatePreviewStyleTool, updatePreviewStyleHandler)
+	addTool(updatePreviewStyleTool, updatePreviewStyleHandler)
 
 	// Template tools
 	createTemplateTool, createTemplateHandler := tools.NewCreateTemplateTool(db, templateService)
-	srv.AddTool(createTemplateTool, createTemplateHandler)
+	addTool(createTemplateTool, createTemplateHandler)
 
 	getTemplateTool, getTemplateHandler := tools.NewGetTemplateTool(db)
-	srv.AddTool(getTemplateTool, getTemplateHandler)
+	addTool(getTemplateTool, getTemplateHandler)
 
 	listTemplatesTool, listTemplatesHandler := tools.NewListTemplatesTool(db)
-	srv.AddTool(listTemplatesTool, listTemplatesHandler)
+	addTool(listTemplatesTool, listTemplatesHandler)
 
 	updateTemplateTool, updateTemplateHandler := tools.NewUpdateTemplateTool(db, templateService)
-	srv.AddTool(updateTemplateTool, updateTemplateHandler)
+	addTool(updateTemplateTool, updateTemplateHandler)
 
 	deleteTemplateTool, deleteTemplateHandler := tools.NewDeleteTemplateTool(db)
-	srv.AddTool(deleteTemplateTool, deleteTemplateHandler)
+	addTool(deleteTemplateTool, deleteTemplateHandler)
+
+	promoteTemplateTool, promoteTemplateHandler := tools.NewPromoteTemplateTool(db)
+	addTool(promoteTemplateTool, promoteTemplateHandler)
+
+	listTemplateRevisionsTool, listTemplateRevisionsHandler := tools.NewListTemplateRevisionsTool(db)
+	addTool(listTemplateRevisionsTool, listTemplateRevisionsHandler)
+
+	getTemplateRevisionTool, getTemplateRevisionHandler := tools.NewGetTemplateRevisionTool(db)
+	addTool(getTemplateRevisionTool, getTemplateRevisionHandler)
+
+	diffTemplateRevisionsTool, diffTemplateRevisionsHandler := tools.NewDiffTemplateRevisionsTool(db)
+	addTool(diffTemplateRevisionsTool, diffTemplateRevisionsHandler)
+
+	rollbackTemplateTool, rollbackTemplateHandler := tools.NewRollbackTemplateTool(db)
+	addTool(rollbackTemplateTool, rollbackTemplateHandler)
+
+	validateTemplateTool, validateTemplateHandler := tools.NewValidateTemplateTool(db, templateService)
+	addTool(validateTemplateTool, validateTemplateHandler)
+
+	exportResumeTool, exportResumeHandler := tools.NewExportResumeTool(db, templateService)
+	addTool(exportResumeTool, exportResumeHandler)
+
+	exportDocxTool, exportDocxHandler := tools.NewExportDocxTool(db, port)
+	addTool(exportDocxTool, exportDocxHandler)
+
+	exportLaTeXTool, exportLaTeXHandler := tools.NewExportLaTeXTool(db, port, templateService)
+	addTool(exportLaTeXTool, exportLaTeXHandler)
+
+	// Partial tools
+	createPartialTool, createPartialHandler := tools.NewCreatePartialTool(db)
+	addTool(createPartialTool, createPartialHandler)
+
+	listPartialsTool, listPartialsHandler := tools.NewListPartialsTool(db)
+	addTool(listPartialsTool, listPartialsHandler)
+
+	updatePartialTool, updatePartialHandler := tools.NewUpdatePartialTool(db, templateService)
+	addTool(updatePartialTool, updatePartialHandler)
+
+	deletePartialTool, deletePartialHandler := tools.NewDeletePartialTool(db)
+	addTool(deletePartialTool, deletePartialHandler)
+
+	listGalleryTemplatesTool, listGalleryTemplatesHandler := tools.NewListGalleryTemplatesTool()
+	addTool(listGalleryTemplatesTool, listGalleryTemplatesHandler)
+
+	installGalleryTemplateTool, installGalleryTemplateHandler := tools.NewInstallGalleryTemplateTool(db, templateService)
+	addTool(installGalleryTemplateTool, installGalleryTemplateHandler)
+
+	// Cover letter tools
+	createCoverLetterTool, createCoverLetterHandler := tools.NewCreateCoverLetterTool(db)
+	addTool(createCoverLetterTool, createCoverLetterHandler)
+
+	getCoverLetterTool, getCoverLetterHandler := tools.NewGetCoverLetterTool(db)
+	addTool(getCoverLetterTool, getCoverLetterHandler)
+
+	listCoverLettersTool, listCoverLettersHandler := tools.NewListCoverLettersTool(db)
+	addTool(listCoverLettersTool, listCoverLettersHandler)
+
+	updateCoverLetterTool, updateCoverLetterHandler := tools.NewUpdateCoverLetterTool(db)
+	addTool(updateCoverLetterTool, updateCoverLetterHandler)
+
+	deleteCoverLetterTool, deleteCoverLetterHandler := tools.NewDeleteCoverLetterTool(db)
+	addTool(deleteCoverLetterTool, deleteCoverLetterHandler)
+
+	generateCoverLetterPreviewTool, generateCoverLetterPreviewHandler := tools.NewGenerateCoverLetterPreviewTool(db, port, templateService)
+	addTool(generateCoverLetterPreviewTool, generateCoverLetterPreviewHandler)
+
+	generateApplicationPacketTool, generateApplicationPacketHandler := tools.NewGenerateApplicationPacketTool(db, port, templateService)
+	addTool(generateApplicationPacketTool, generateApplicationPacketHandler)
 
 	getResumeContextTool, getResumeContextHandler := tools.NewGetResumeContextTool(db)
-	srv.AddTool(getResumeContextTool, getResumeContextHandler)
+	addTool(getResumeContextTool, getResumeContextHandler)
+
+	renderPDFTool, renderPDFHandler := tools.NewRenderPDFTool(db, templateService)
+	addTool(renderPDFTool, renderPDFHandler)
+
+	diffResumesTool, diffResumesHandler := tools.NewDiffResumesTool(db)
+	addTool(diffResumesTool, diffResumesHandler)
+
+	analyzeJobMatchTool, analyzeJobMatchHandler := tools.NewAnalyzeJobMatchTool(db)
+	addTool(analyzeJobMatchTool, analyzeJobMatchHandler)
+
+	lintResumeTool, lintResumeHandler := tools.NewLintResumeTool(db)
+	addTool(lintResumeTool, lintResumeHandler)
+
+	getCareerTimelineTool, getCareerTimelineHandler := tools.NewGetCareerTimelineTool(db)
+	addTool(getCareerTimelineTool, getCareerTimelineHandler)
+
+	importJSONResumeTool, importJSONResumeHandler := tools.NewImportJSONResumeTool(db)
+	addTool(importJSONResumeTool, importJSONResumeHandler)
+
+	exportJSONResumeTool, exportJSONResumeHandler := tools.NewExportJSONResumeTool(db)
+	addTool(exportJSONResumeTool, exportJSONResumeHandler)
+
+	importLinkedInTool, importLinkedInHandler := tools.NewImportLinkedInTool(db, s.localFiles)
+	addTool(importLinkedInTool, importLinkedInHandler)
+
+	// Resource templates, their arguments can be completed by clients
+	resumeResource, resumeResourceHandler := resources.NewResumeResourceTemplate(db)
+	srv.AddResourceTemplate(resumeResource, resumeResourceHandler)
+
+	templateResource, templateResourceHandler := resources.NewTemplateResourceTemplate(db)
+	srv.AddResourceTemplate(templateResource, templateResourceHandler)
+
+	previewResource, previewResourceHandler := resources.NewPreviewResourceTemplate(db, port)
+	srv.AddResourceTemplate(previewResource, previewResourceHandler)
+
+	if s.stopNotifications != nil {
+		s.stopNotifications()
+	}
+	s.stopNotifications = subscriptions.listen(srv, s.broker)
 
 	s.server = srv
 }
@@ -102,6 +238,20 @@ func (s *MCPServer) UpdatePort(port string) {
 	s.InitializeTools(s.db, port, s.templateService)
 }
 
+// SetToolConfig changes which tools are exposed and re-initializes the server.
+// It has to be called before the server is started.
+func (s *MCPServer) SetToolConfig(config ToolConfig) {
+	s.toolConfig = config
+	s.InitializeTools(s.db, s.port, s.templateService)
+}
+
+// AllowLocalFiles lets tools read files by path and re-initializes the server.
+// It has to be called before the server is started.
+func (s *MCPServer) AllowLocalFiles() {
+	s.localFiles = true
+	s.InitializeTools(s.db, s.port, s.templateService)
+}
+
 func (s *MCPServer) Start() error {
 	return server.ServeStdio(s.server)
 }
@@ -0,0 +1,201 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// ProfileFull registers every tool
+	ProfileFull = "full"
+	// ProfileReadOnly only registers tools that read resumes or render previews
+	ProfileReadOnly = "read-only"
+)
+
+// readOnlyTools lists the tools that never create, change or delete resume data.
+// Rendering tools may still store the preview session they render from.
+var readOnlyTools = []string{
+	"get_resume_by_name",
+	"list_resumes",
+	"generate_preview",
+	"get_template",
+	"list_templates",
+	"list_template_revisions",
+	"get_template_revision",
+	"diff_template_revisions",
+	"validate_template",
+	"export_resume",
+	"export_docx",
+	"export_latex",
+	"list_partials",
+	"list_gallery_templates",
+	"get_resume_context",
+	"render_pdf",
+	"get_cover_letter",
+	"list_cover_letters",
+	"generate_cover_letter_preview",
+	"diff_resumes",
+	"analyze_job_match",
+	"lint_resume",
+	"get_career_timeline",
+	"export_json_resume",
+}
+
+// ToolProfile decides which tools are exposed by the MCP server.
+// Allow, when not empty, replaces the tools of the named profile.
+// Deny always wins over both the profile and Allow.
+type ToolProfile struct {
+	Name  string
+	Allow []string
+	Deny  []string
+}
+
+// ToolConfig holds the default tool profile and optional per-role overrides used by the HTTP mode
+type ToolConfig struct {
+	Default ToolProfile
+	Roles   map[string]ToolProfile
+}
+
+// NewToolProfile builds a profile from a profile name and comma separated allow and deny lists
+func NewToolProfile(name, allow, deny string) (ToolProfile, error) {
+	if name == "" {
+		name = ProfileFull
+	}
+	if name != ProfileFull && name != ProfileReadOnly {
+		return ToolProfile{}, fmt.Errorf("unknown tool profile: %s", name)
+	}
+	return ToolProfile{
+		Name:  name,
+		Allow: splitList(allow),
+		Deny:  splitList(deny),
+	}, nil
+}
+
+// ParseRoleProfiles parses a role mapping such as "admin=full,viewer=read-only".
+// A profile may be followed by allow and deny lists of | separated tool names,
+// e.g. "viewer=read-only;deny=generate_preview|get_resume_context".
+func ParseRoleProfiles(value string) (map[string]ToolProfile, error) {
+	roles := map[string]ToolProfile{}
+	for _, entry := range splitList(value) {
+		role, spec, ok := strings.Cut(entry, "=")
+		if !ok || strings.TrimSpace(role) == "" {
+			return nil, fmt.Errorf("invalid role profile entry: %s", entry)
+		}
+		fields := strings.Split(spec, ";")
+		var allow, deny string
+		for _, field := range fields[1:] {
+			key, tools, ok := strings.Cut(field, "=")
+			switch strings.TrimSpace(key) {
+			case "allow":
+				allow = strings.ReplaceAll(tools, "|", ",")
+			case "deny":
+				deny = strings.ReplaceAll(tools, "|", ",")
+			default:
+				ok = false
+			}
+			if !ok {
+				return nil, fmt.Errorf("invalid role profile entry: %s", entry)
+			}
+		}
+		profile, err := NewToolProfile(strings.TrimSpace(fields[0]), allow, deny)
+		if err != nil {
+			return nil, err
+		}
+		roles[strings.TrimSpace(role)] = profile
+	}
+	return roles, nil
+}
+
+// Allows reports whether the tool with the given name is exposed by the profile
+func (p ToolProfile) Allows(name string) bool {
+	if slices.Contains(p.Deny, name) {
+		return false
+	}
+	if len(p.Allow) > 0 {
+		return slices.Contains(p.Allow, name)
+	}
+	if p.Name == ProfileReadOnly {
+		return slices.Contains(readOnlyTools, name)
+	}
+	return true
+}
+
+// Registers reports whether a tool has to be registered on the server,
+// which is the case when the default profile or any role profile allows it
+func (c ToolConfig) Registers(name string) bool {
+	if c.Default.Allows(name) {
+		return true
+	}
+	for _, profile := range c.Roles {
+		if profile.Allows(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsForContext checks the tool against the profiles of the authenticated user's roles.
+// Users without a configured role fall back to the default profile.
+func (c ToolConfig) AllowsForContext(ctx context.Context, name string) bool {
+	user := authenticatedUser(ctx)
+	if user == nil || len(c.Roles) == 0 {
+		return c.Default.Allows(name)
+	}
+
+	matched := false
+	for _, role := range user.Roles {
+		profile, ok := c.Roles[role]
+		if !ok {
+			continue
+		}
+		matched = true
+		if profile.Allows(name) {
+			return true
+		}
+	}
+	if !matched {
+		return c.Default.Allows(name)
+	}
+	return false
+}
+
+// toolFilter hides tools that the current user's role may not use from list_tools
+func (c ToolConfig) toolFilter() server.ToolFilterFunc {
+	return func(ctx context.Context, tools []mcp.Tool) []mcp.Tool {
+		filtered := make([]mcp.Tool, 0, len(tools))
+		for _, tool := range tools {
+			if c.AllowsForContext(ctx, tool.Name) {
+				filtered = append(filtered, tool)
+			}
+		}
+		return filtered
+	}
+}
+
+// toolMiddleware rejects calls to tools that the current user's role may not use
+func (c ToolConfig) toolMiddleware() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if !c.AllowsForContext(ctx, request.Params.Name) {
+				return mcp.NewToolResultError(fmt.Sprintf("Tool %s is not available for your role", request.Params.Name)), nil
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
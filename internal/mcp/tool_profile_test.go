@@ -0,0 +1,103 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func TestNewToolProfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		profile  string
+		allow    string
+		deny     string
+		tool     string
+		expected bool
+	}{
+		{name: "default profile allows everything", tool: "delete_resume", expected: true},
+		{name: "read-only allows list", profile: ProfileReadOnly, tool: "list_resumes", expected: true},
+		{name: "read-only allows preview", profile: ProfileReadOnly, tool: "generate_preview", expected: true},
+		{name: "read-only denies delete", profile: ProfileReadOnly, tool: "delete_resume", expected: false},
+		{name: "allow list replaces profile", profile: ProfileReadOnly, allow: "update_template", tool: "update_template", expected: true},
+		{name: "allow list hides other tools", allow: "list_resumes, get_template", tool: "delete_template", expected: false},
+		{name: "deny wins over allow", allow: "delete_resume", deny: "delete_resume", tool: "delete_resume", expected: false},
+		{name: "deny on full profile", deny: "delete_resume,delete_template", tool: "delete_template", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, err := NewToolProfile(tt.profile, tt.allow, tt.deny)
+			if err != nil {
+				t.Fatalf("NewToolProfile() error = %v", err)
+			}
+			if got := profile.Allows(tt.tool); got != tt.expected {
+				t.Errorf("Allows(%s) = %v, want %v", tt.tool, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewToolProfile_UnknownProfile(t *testing.T) {
+	if _, err := NewToolProfile("write-only", "", ""); err == nil {
+		t.Error("Expected error for unknown profile")
+	}
+}
+
+func TestParseRoleProfiles(t *testing.T) {
+	roles, err := ParseRoleProfiles("admin=full, viewer=read-only")
+	if err != nil {
+		t.Fatalf("ParseRoleProfiles() error = %v", err)
+	}
+	if roles["admin"].Name != ProfileFull {
+		t.Errorf("Expected admin profile %s, got %s", ProfileFull, roles["admin"].Name)
+	}
+	if roles["viewer"].Name != ProfileReadOnly {
+		t.Errorf("Expected viewer profile %s, got %s", ProfileReadOnly, roles["viewer"].Name)
+	}
+
+	if _, err := ParseRoleProfiles("viewer"); err == nil {
+		t.Error("Expected error for entry without profile")
+	}
+
+	roles, err = ParseRoleProfiles("viewer=read-only;deny=generate_preview, editor=full;allow=list_resumes|update_resume")
+	if err != nil {
+		t.Fatalf("ParseRoleProfiles() error = %v", err)
+	}
+	if roles["viewer"].Allows("generate_preview") || !roles["viewer"].Allows("list_resumes") {
+		t.Errorf("Expected viewer to deny generate_preview only, got %+v", roles["viewer"])
+	}
+	if !roles["editor"].Allows("update_resume") || roles["editor"].Allows("delete_resume") {
+		t.Errorf("Expected editor to allow the listed tools only, got %+v", roles["editor"])
+	}
+
+	if _, err := ParseRoleProfiles("viewer=read-only;block=generate_preview"); err == nil {
+		t.Error("Expected error for unknown role profile option")
+	}
+}
+
+func TestToolConfig_AllowsForContext(t *testing.T) {
+	config := ToolConfig{
+		Default: ToolProfile{Name: ProfileReadOnly},
+		Roles: map[string]ToolProfile{
+			"admin": {Name: ProfileFull},
+		},
+	}
+
+	admin := types.WithAuthenticatedUser(context.Background(), &types.AuthenticatedUser{Sub: "a", Roles: []string{"admin"}})
+	viewer := types.WithAuthenticatedUser(context.Background(), &types.AuthenticatedUser{Sub: "v", Roles: []string{"user"}})
+
+	if !config.AllowsForContext(admin, "delete_resume") {
+		t.Error("Expected admin to be allowed to delete resumes")
+	}
+	if config.AllowsForContext(viewer, "delete_resume") {
+		t.Error("Expected user without role profile to fall back to read-only")
+	}
+	if config.AllowsForContext(context.Background(), "delete_resume") {
+		t.Error("Expected anonymous context to fall back to read-only")
+	}
+	if !config.Registers("delete_resume") {
+		t.Error("Expected delete_resume to be registered for the admin role")
+	}
+}
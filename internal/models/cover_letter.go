@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// CoverLetter is a letter written for an application and rendered with cover letter templates.
+// Templates can use the resume's data through .Resume.
+type CoverLetter struct {
+	ID        uint                 `gorm:"primaryKey" json:"id"`
+	ResumeID  uint                 `gorm:"not null" json:"resume_id"`
+	Recipient string               `json:"recipient"`
+	Company   string               `gorm:"not null" json:"company"`
+	Date      time.Time            `json:"date"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+	Resume    Resume               `gorm:"foreignKey:ResumeID" json:"-"`
+	Sections  []CoverLetterSection `gorm:"foreignKey:CoverLetterID;constraint:OnDelete:CASCADE" json:"sections,omitempty"`
+	UserID    string               `gorm:"not null" json:"user_id"`
+}
+
+// CoverLetterSection is a body section of a cover letter, ordered by Position
+type CoverLetterSection struct {
+	ID            uint   `gorm:"primaryKey" json:"id"`
+	CoverLetterID uint   `gorm:"not null" json:"cover_letter_id"`
+	Position      int    `gorm:"not null" json:"position"`
+	Heading       string `json:"heading"`
+	Body          string `gorm:"type:text;not null" json:"body"`
+	UserID        string `gorm:"not null" json:"user_id"`
+}
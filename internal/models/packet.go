@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// ApplicationPacket is a set of documents rendered and merged into a single PDF, e.g. a resume
+// and a cover letter sent together. Like preview sessions it keeps a copy of every template.
+type ApplicationPacket struct {
+	ID        string           `gorm:"primaryKey" json:"id"`
+	CreatedAt time.Time        `json:"created_at"`
+	Documents []PacketDocument `gorm:"foreignKey:PacketID;constraint:OnDelete:CASCADE" json:"documents"`
+	UserID    string           `gorm:"not null" json:"user_id"`
+}
+
+// PacketDocument is a document of an application packet, ordered by Position.
+// It renders the cover letter when CoverLetterID is set and the resume otherwise.
+type PacketDocument struct {
+	ID            uint   `gorm:"primaryKey" json:"id"`
+	PacketID      string `gorm:"not null;index" json:"packet_id"`
+	Position      int    `gorm:"not null" json:"position"`
+	Title         string `gorm:"not null" json:"title"`
+	ResumeID      uint   `gorm:"not null" json:"resume_id"`
+	CoverLetterID *uint  `json:"cover_letter_id,omitempty"`
+	Template      string `gorm:"type:text;not null" json:"template"`
+	CSS           string `gorm:"type:text" json:"css"`
+	UserID        string `gorm:"not null" json:"user_id"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// Partial is a reusable piece of template owned by a user, e.g. a header, a contact block or a base
+// layout. Templates of the user include it by name with {{template "name" .}}.
+type Partial struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	Name         string    `gorm:"not null;uniqueIndex:idx_partials_user_name" json:"name"`
+	Description  string    `json:"description"`
+	TemplateData string    `gorm:"type:text;not null" json:"template_data"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	UserID       string    `gorm:"not null;uniqueIndex:idx_partials_user_name" json:"user_id"`
+}
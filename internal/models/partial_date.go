@@ -0,0 +1,161 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Precisions of a date, stored next to the date so that templates don't print invented days or months
+const (
+	DatePrecisionYear  = "year"
+	DatePrecisionMonth = "month"
+	DatePrecisionDay   = "day"
+)
+
+// DatePresent is accepted as end date of current roles and stored as a missing end date
+const DatePresent = "present"
+
+// PartialDate is a date known to a year, month or day precision.
+// Time is the first day of the period, e.g. 2020-03-01 for March 2020.
+type PartialDate struct {
+	Time      time.Time
+	Precision string
+}
+
+// ParsePartialDate parses a date in YYYY, YYYY-MM or YYYY-MM-DD format
+func ParsePartialDate(value string) (PartialDate, error) {
+	value = strings.TrimSpace(value)
+	for _, format := range []struct {
+		layout    string
+		precision string
+	}{
+		{"2006-01-02", DatePrecisionDay},
+		{"2006-01", DatePrecisionMonth},
+		{"2006", DatePrecisionYear},
+	} {
+		if len(value) != len(format.layout) {
+			continue
+		}
+		if t, err := time.Parse(format.layout, value); err == nil {
+			return PartialDate{Time: t, Precision: format.precision}, nil
+		}
+	}
+	return PartialDate{}, fmt.Errorf("%q is not a date in YYYY, YYYY-MM or YYYY-MM-DD format", value)
+}
+
+// ParseEndDate parses an end date like ParsePartialDate. Empty values and "present"
+// mean the entry is ongoing and return nil.
+func ParseEndDate(value string) (*PartialDate, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.EqualFold(value, DatePresent) {
+		return nil, nil
+	}
+	date, err := ParsePartialDate(value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+// IsZero reports whether the date is unset, e.g. the end of an ongoing entry
+func (d PartialDate) IsZero() bool {
+	return d.Time.IsZero()
+}
+
+// String returns the date in YYYY, YYYY-MM or YYYY-MM-DD format depending on its precision
+func (d PartialDate) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	switch d.Precision {
+	case DatePrecisionYear:
+		return d.Time.Format("2006")
+	case DatePrecisionMonth:
+		return d.Time.Format("2006-01")
+	}
+	return d.Time.Format("2006-01-02")
+}
+
+// EndOfPeriod returns the first day after the date's period, e.g. 2021-01-01 for 2020 and
+// 2020-04-01 for March 2020, so that an entry ending "2020" is counted through December.
+func (d PartialDate) EndOfPeriod() time.Time {
+	if d.IsZero() {
+		return time.Time{}
+	}
+	switch d.Precision {
+	case DatePrecisionYear:
+		return d.Time.AddDate(1, 0, 0)
+	case DatePrecisionMonth:
+		return d.Time.AddDate(0, 1, 0)
+	}
+	return d.Time.AddDate(0, 0, 1)
+}
+
+// Format formats the date with a Go time layout, leaving out what isn't known.
+// Year dates are always formatted as the year and month dates fall back to
+// "Jan 2006" when the layout includes the day.
+func (d PartialDate) Format(layout string) string {
+	if d.IsZero() {
+		return ""
+	}
+	switch d.Precision {
+	case DatePrecisionYear:
+		return d.Time.Format("2006")
+	case DatePrecisionMonth:
+		if layoutShowsDay(layout) {
+			layout = "Jan 2006"
+		}
+	}
+	return d.Time.Format(layout)
+}
+
+// layoutShowsDay reports whether the layout prints the day of month or weekday
+func layoutShowsDay(layout string) bool {
+	day := time.Date(2001, time.January, 10, 0, 0, 0, 0, time.UTC)
+	return day.Format(layout) != day.AddDate(0, 0, 1).Format(layout)
+}
+
+// datePrecision returns the stored precision, rows created before precisions were recorded have full dates
+func datePrecision(precision string) string {
+	if precision == "" {
+		return DatePrecisionDay
+	}
+	return precision
+}
+
+// Start returns the start date with its precision
+func (w WorkExperience) Start() PartialDate {
+	return PartialDate{Time: w.StartDate, Precision: datePrecision(w.StartDatePrecision)}
+}
+
+// End returns the end date with its precision, zero for current roles
+func (w WorkExperience) End() PartialDate {
+	if w.EndDate == nil {
+		return PartialDate{}
+	}
+	return PartialDate{Time: *w.EndDate, Precision: datePrecision(w.EndDatePrecision)}
+}
+
+// Current reports whether the role is ongoing
+func (w WorkExperience) Current() bool {
+	return w.EndDate == nil || w.EndDate.IsZero()
+}
+
+// Start returns the start date with its precision
+func (e Education) Start() PartialDate {
+	return PartialDate{Time: e.StartDate, Precision: datePrecision(e.StartDatePrecision)}
+}
+
+// End returns the end date with its precision, zero while still studying
+func (e Education) End() PartialDate {
+	if e.EndDate == nil {
+		return PartialDate{}
+	}
+	return PartialDate{Time: *e.EndDate, Precision: datePrecision(e.EndDatePrecision)}
+}
+
+// Current reports whether the education is ongoing
+func (e Education) Current() bool {
+	return e.EndDate == nil || e.EndDate.IsZero()
+}
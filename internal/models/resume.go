@@ -42,8 +42,11 @@ type WorkExperience struct {
 	Type      string     `gorm:"default:fulltime" json:"type"` // fulltime, parttime, internship
 	StartDate time.Time  `json:"start_date"`
 	EndDate   *time.Time `json:"end_date"`
-	Resume    Resume     `gorm:"foreignKey:ResumeID" json:"-"`
-	Category  string     `gorm:"not null" json:"category"`
+	// StartDatePrecision and EndDatePrecision record how much of the dates is known: year, month or day
+	StartDatePrecision string `gorm:"not null;default:day" json:"start_date_precision"`
+	EndDatePrecision   string `gorm:"not null;default:day" json:"end_date_precision"`
+	Resume             Resume `gorm:"foreignKey:ResumeID" json:"-"`
+	Category           string `gorm:"not null" json:"category"`
 
 	FeatureMaps []FeatureMap `gorm:"foreignKey:ExperienceID;constraint:OnDelete:CASCADE" json:"feature_maps,omitempty"`
 	UserID      string       `gorm:"not null" json:"user_id"`
@@ -56,8 +59,11 @@ type Education struct {
 	Type       string     `gorm:"default:fulltime" json:"type"` // fulltime, parttime, internship
 	StartDate  time.Time  `json:"start_date"`
 	EndDate    *time.Time `json:"end_date"`
-	Resume     Resume     `gorm:"foreignKey:ResumeID" json:"-"`
-	Category   string     `gorm:"not null" json:"category"`
+	// StartDatePrecision and EndDatePrecision record how much of the dates is known: year, month or day
+	StartDatePrecision string `gorm:"not null;default:day" json:"start_date_precision"`
+	EndDatePrecision   string `gorm:"not null;default:day" json:"end_date_precision"`
+	Resume             Resume `gorm:"foreignKey:ResumeID" json:"-"`
+	Category           string `gorm:"not null" json:"category"`
 
 	FeatureMaps []FeatureMap `gorm:"foreignKey:ExperienceID;constraint:OnDelete:CASCADE" json:"feature_maps,omitempty"`
 	UserID      string       `gorm:"not null" json:"user_id"`
@@ -90,16 +96,57 @@ type PreviewSession struct {
 	CreatedAt time.Time `json:"created_at"`
 	Resume    Resume    `gorm:"foreignKey:ResumeID" json:"resume"`
 	UserID    string    `gorm:"not null" json:"user_id"`
+	// CoverLetterID is set when the session previews a cover letter instead of the resume
+	CoverLetterID *uint `json:"cover_letter_id,omitempty"`
+	// TemplateID is the template rendered by the session. Unless TemplateRevision pins a revision,
+	// the session follows the template's latest data.
+	TemplateID       *uint `json:"template_id,omitempty"`
+	TemplateRevision *int  `json:"template_revision,omitempty"`
+	// DocxStyles are the styles of DOCX exports of the session as JSON, the default styles when empty
+	DocxStyles string `gorm:"type:text" json:"docx_styles,omitempty"`
+	// Format is the format of the session's template, LaTeX sessions offer the .tex source and a compiled PDF
+	Format string `gorm:"not null;default:html" json:"format"`
 }
 
+// Template types, resume templates render a Resume and cover letter templates a CoverLetter
+const (
+	TemplateTypeResume      = "resume"
+	TemplateTypeCoverLetter = "cover_letter"
+)
+
+// Template formats, HTML templates are rendered to web pages and PDFs by a browser and LaTeX
+// templates to LaTeX source, which is compiled to PDF by a LaTeX compiler
+const (
+	TemplateFormatHTML  = "html"
+	TemplateFormatLaTeX = "latex"
+)
+
 type Template struct {
-	ID           uint      `gorm:"primaryKey" json:"id"`
-	ResumeID     uint      `gorm:"not null" json:"resume_id"`
+	ID uint `gorm:"primaryKey" json:"id"`
+	// ResumeID is the resume owning the template, nil for user templates usable with any of the user's resumes
+	ResumeID     *uint     `gorm:"index" json:"resume_id"`
 	Name         string    `gorm:"not null" json:"name"`
 	Description  string    `json:"description"`
+	Type         string    `gorm:"not null;default:resume" json:"type"`
+	Format       string    `gorm:"not null;default:html" json:"format"`
 	TemplateData string    `gorm:"type:text;not null" json:"template_data"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 	Resume       Resume    `gorm:"foreignKey:ResumeID" json:"-"`
 	UserID       string    `gorm:"not null" json:"user_id"`
 }
+
+// IsUserTemplate reports whether the template belongs to the user instead of a single resume
+func (t Template) IsUserTemplate() bool {
+	return t.ResumeID == nil
+}
+
+// IsLaTeX reports whether the template renders LaTeX instead of HTML
+func (t Template) IsLaTeX() bool {
+	return t.Format == TemplateFormatLaTeX
+}
+
+// UsableWith reports whether the template may render the resume with the given ID
+func (t Template) UsableWith(resumeID uint) bool {
+	return t.ResumeID == nil || *t.ResumeID == resumeID
+}
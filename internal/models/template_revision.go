@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// TemplateRevision is a saved version of a template's data. Revisions are numbered from 1
+// for every template and recorded whenever the template data changes.
+type TemplateRevision struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	TemplateID   uint      `gorm:"not null;index" json:"template_id"`
+	Revision     int       `gorm:"not null" json:"revision"`
+	TemplateData string    `gorm:"type:text;not null" json:"template_data"`
+	Note         string    `json:"note"`
+	CreatedAt    time.Time `json:"created_at"`
+	UserID       string    `gorm:"not null" json:"user_id"`
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"log"
+	"math"
+	"os"
+	"time"
+
+	"github.com/chromedp/cdproto/emulation"
+	"github.com/chromedp/cdproto/page"
+	"github.com/chromedp/chromedp"
+)
+
+// Size and margins of the printed page at 96 DPI: US Letter with margins of about 1cm,
+// which PDFs and page images share
+const (
+	pageWidthPx  = 816
+	pageHeightPx = 1056
+	pageMarginPx = 38
+
+	// MaxPageImages limits how many page screenshots are captured for one document
+	MaxPageImages = 10
+)
+
+// renderInBrowser loads html in a headless Chrome and runs actions on the loaded page.
+// It reports the browser acquired and page loaded stages to progress.
+func (s *TemplateService) renderInBrowser(ctx context.Context, html string, timeout time.Duration, progress PDFProgressFunc, actions ...chromedp.Action) error {
+	// Get remote Chrome URL from environment
+	remoteURL := os.Getenv("CHROMEDP_REMOTE_URL")
+
+	var browserCtx context.Context
+	var cancel context.CancelFunc
+	var allocCancel context.CancelFunc
+
+	if remoteURL != "" {
+		// Use remote Chrome instance
+		var allocCtx context.Context
+		allocCtx, allocCancel = chromedp.NewRemoteAllocator(ctx, remoteURL)
+		// Ensure allocator is always cancelled
+		defer func() {
+			if allocCancel != nil {
+				allocCancel()
+			}
+		}()
+		browserCtx, cancel = chromedp.NewContext(allocCtx)
+	} else {
+		// Use local Chrome instance
+		browserCtx, cancel = chromedp.NewContext(ctx)
+	}
+	// Ensure context is always cancelled
+	defer func() {
+		if cancel != nil {
+			cancel()
+		}
+	}()
+
+	// Set timeout - use a separate variable to avoid shadowing cancel
+	timeoutCtx, timeoutCancel := context.WithTimeout(browserCtx, timeout)
+	defer timeoutCancel()
+
+	// Running without actions starts the browser
+	err := chromedp.Run(timeoutCtx)
+	if err == nil {
+		progress(PDFStageBrowserAcquired, 2, PDFStageCount)
+
+		err = chromedp.Run(timeoutCtx, chromedp.Navigate(htmlDataURL(html)))
+	}
+	if err == nil {
+		progress(PDFStagePageLoaded, 3, PDFStageCount)
+
+		err = chromedp.Run(timeoutCtx, actions...)
+	}
+
+	if err != nil && ctx.Err() == context.Canceled {
+		return ctx.Err()
+	}
+	return err
+}
+
+// htmlDataURL returns a data URL of the page. The page is base64 encoded, a # in the inlined
+// styles would otherwise start the URL fragment and cut the page off.
+func htmlDataURL(html string) string {
+	return "data:text/html;base64," + base64.StdEncoding.EncodeToString([]byte(html))
+}
+
+// printToPDF prints the loaded page on the paper size and margins of the page images
+func printToPDF() *page.PrintToPDFParams {
+	margin := float64(pageMarginPx) / 96
+	return page.PrintToPDF().
+		WithPrintBackground(true).
+		WithPaperWidth(float64(pageWidthPx) / 96).
+		WithPaperHeight(float64(pageHeightPx) / 96).
+		WithMarginTop(margin).
+		WithMarginBottom(margin).
+		WithMarginLeft(margin).
+		WithMarginRight(margin)
+}
+
+// pageBreaksScript returns the top and height of each page's slice of the document. Pages end
+// before forced breaks and before break-inside: avoid elements that would be split, like in print.
+const pageBreaksScript = `(() => {
+	const pageHeight = %d, maxPages = %d;
+	const forced = ["page", "always", "left", "right"];
+	const total = document.documentElement.scrollHeight;
+	const elements = Array.from(document.body.querySelectorAll("*")).map(element => {
+		const style = getComputedStyle(element);
+		const rect = element.getBoundingClientRect();
+		return {
+			top: rect.top + window.scrollY,
+			bottom: rect.bottom + window.scrollY,
+			avoid: style.breakInside.startsWith("avoid"),
+			before: forced.includes(style.breakBefore),
+			after: forced.includes(style.breakAfter),
+		};
+	});
+	const pages = [];
+	let start = 0;
+	while (start < total && pages.length < maxPages) {
+		let end = Math.min(start + pageHeight, total);
+		for (const e of elements) {
+			if (e.before && e.top > start && e.top < end) end = e.top;
+			if (e.after && e.bottom > start && e.bottom < end) end = e.bottom;
+		}
+		for (const e of elements) {
+			if (e.avoid && e.top > start && e.top < end && e.bottom > end && e.bottom - e.top <= pageHeight) end = e.top;
+		}
+		pages.push([start, end - start]);
+		start = end;
+	}
+	return pages.length ? pages : [[0, pageHeight]];
+})()`
+
+// GeneratePageImages renders the resume with print styles and returns a PNG image of each page.
+// The content is laid out at the printable width of the PDF paper and sliced into pages at
+// forced breaks and around break-inside: avoid elements, then placed inside the PDF margins.
+func (s *TemplateService) GeneratePageImages(ctx context.Context, templateStr, css string, data any, timeout time.Duration) ([][]byte, error) {
+	if timeout <= 0 {
+		timeout = DefaultPDFTimeout
+	}
+
+	html, err := s.GeneratePreviewWithOptions(templateStr, css, data, false, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	contentWidth := pageWidthPx - 2*pageMarginPx
+	contentHeight := pageHeightPx - 2*pageMarginPx
+
+	var images [][]byte
+	err = s.renderInBrowser(ctx, html, timeout, func(string, int, int) {},
+		chromedp.EmulateViewport(int64(contentWidth), int64(contentHeight)),
+		emulation.SetEmulatedMedia().WithMedia("print"),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			var pages [][2]float64
+			if err := chromedp.Evaluate(fmt.Sprintf(pageBreaksScript, contentHeight, MaxPageImages), &pages).Do(ctx); err != nil {
+				return err
+			}
+
+			for _, p := range pages {
+				buf, err := page.CaptureScreenshot().
+					WithFormat(page.CaptureScreenshotFormatPng).
+					WithCaptureBeyondViewport(true).
+					WithClip(&page.Viewport{
+						X:      0,
+						Y:      p[0],
+						Width:  float64(contentWidth),
+						Height: math.Max(1, p[1]),
+						Scale:  1,
+					}).
+					Do(ctx)
+				if err != nil {
+					return err
+				}
+				image, err := placeOnPage(buf)
+				if err != nil {
+					return err
+				}
+				images = append(images, image)
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		log.Printf("Page image generation error: %v", err)
+		log.SetOutput(io.Discard)
+		return nil, fmt.Errorf("Page image generation error: %w", err)
+	}
+
+	return images, nil
+}
+
+// placeOnPage draws the PNG content of a page inside the margins of a blank page
+func placeOnPage(content []byte) ([]byte, error) {
+	src, err := png.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, pageWidthPx, pageHeightPx))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	area := image.Rect(pageMarginPx, pageMarginPx, pageWidthPx-pageMarginPx, pageHeightPx-pageMarginPx)
+	draw.Draw(dst, area, src, src.Bounds().Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, dst); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
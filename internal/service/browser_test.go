@@ -0,0 +1,36 @@
+package service
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func TestHTMLDataURL(t *testing.T) {
+	html, err := NewTemplateService().GeneratePreviewWithOptions(`<h1 class="text-gray-500">{{.Name}} #1</h1>`, "h1 { color: #333; }", models.Resume{Name: "Jane"}, false, "", "")
+	if err != nil {
+		t.Fatalf("GeneratePreviewWithOptions() error = %v", err)
+	}
+	if !strings.Contains(html, "#") {
+		t.Fatal("Expected the inlined styles to contain #")
+	}
+
+	url := htmlDataURL(html)
+	if strings.Contains(url, "#") {
+		t.Errorf("Expected no # in the data URL, Chrome would cut the page off at the fragment")
+	}
+
+	encoded, ok := strings.CutPrefix(url, "data:text/html;base64,")
+	if !ok {
+		t.Fatalf("Expected a base64 HTML data URL, got %.40s", url)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("Invalid base64: %v", err)
+	}
+	if string(decoded) != html {
+		t.Error("Expected the data URL to decode to the page")
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	texttemplate "text/template"
+	"text/template/parse"
+
+	"github.com/rxtech-lab/resume-mcp/internal/latex"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// latexEscaperName is the function appended to every action of LaTeX templates
+const latexEscaperName = "_latexEscape"
+
+// GenerateLaTeX renders a LaTeX template to LaTeX source. Every value the template prints is escaped,
+// like html/template escapes values for HTML, so names like "R&D" or "100%" can't break the document.
+// markdown converts Markdown to LaTeX and raw prints a value holding LaTeX as it is.
+func (s *TemplateService) GenerateLaTeX(templateStr string, data any) (string, error) {
+	tmpl, err := s.parseLaTeX(templateStr)
+	if err != nil {
+		return "", fmt.Errorf("Template parse error: %v", err)
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("Template execution error: %v", err)
+	}
+	return out.String(), nil
+}
+
+// RenderTemplate renders the template in its format, as a preview page for HTML templates and as
+// LaTeX source for LaTeX templates. Tools use it to check templates before saving them.
+func (s *TemplateService) RenderTemplate(templateStr, format string, data any) (string, error) {
+	if format == models.TemplateFormatLaTeX {
+		return s.GenerateLaTeX(templateStr, data)
+	}
+	return s.GeneratePreview(templateStr, "", data)
+}
+
+// LaTeXFileName is the file name offered when downloading the LaTeX source of the data
+func LaTeXFileName(data any) string {
+	return strings.TrimSuffix(PDFFileName(data), ".pdf") + ".tex"
+}
+
+// parseLaTeX parses a LaTeX template with its partials and escapes the output of all their actions
+func (s *TemplateService) parseLaTeX(templateStr string) (*texttemplate.Template, error) {
+	funcs := texttemplate.FuncMap(templateFuncs())
+	funcs["markdown"] = latex.FromMarkdown
+	funcs["raw"] = func(text string) latex.Source { return latex.Source(text) }
+	funcs[latexEscaperName] = escapeLaTeXValue
+
+	tmpl, err := s.parseText(templateStr, funcs)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			escapeActions(t.Tree, t.Tree.Root)
+		}
+	}
+	return tmpl, nil
+}
+
+// escapeLaTeXValue prints the value escaped for LaTeX, unless it already is LaTeX source
+func escapeLaTeXValue(value any) latex.Source {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case latex.Source:
+		return v
+	}
+	return latex.Source(latex.Escape(fmt.Sprint(value)))
+}
+
+// escapeActions appends the escaper to the pipeline of every action printing a value
+func escapeActions(tree *parse.Tree, node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			escapeActions(tree, child)
+		}
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) > 0 {
+			return
+		}
+		escaper := parse.NewIdentifier(latexEscaperName).SetTree(tree).SetPos(n.Pos)
+		n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{NodeType: parse.NodeCommand, Pos: n.Pos, Args: []parse.Node{escaper}})
+	case *parse.IfNode:
+		escapeActions(tree, n.List)
+		escapeActions(tree, n.ElseList)
+	case *parse.RangeNode:
+		escapeActions(tree, n.List)
+		escapeActions(tree, n.ElseList)
+	case *parse.WithNode:
+		escapeActions(tree, n.List)
+		escapeActions(tree, n.ElseList)
+	}
+}
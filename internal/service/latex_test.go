@@ -0,0 +1,87 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+const testLaTeXTemplate = `\documentclass{article}
+\begin{document}
+\section*{ {{.Name}} }
+{{.Description | markdown}}
+{{range sortByDate "desc" .WorkExperiences}}{{$dates := dateRange . "Jan 2006"}}
+\textbf{ {{.JobTitle}} } at {{.Company}} \hfill {{$dates}}
+{{range .FeatureMaps}}{{if .Key}}{{.Key}}: {{end}}{{markdown .Value}}
+{{end}}{{end}}{{raw "\\vspace{1em}"}}
+\end{document}`
+
+func TestGenerateLaTeX(t *testing.T) {
+	resume := exportTestResume()
+	resume.WorkExperiences[0].Company = "R&D_Lab #1"
+
+	source, err := NewTemplateService().GenerateLaTeX(testLaTeXTemplate, resume)
+	if err != nil {
+		t.Fatalf("GenerateLaTeX() error = %v", err)
+	}
+
+	for _, expected := range []string{
+		`\section*{ Jane \textless{}Doe\textgreater{} }`,
+		`Backend engineer \& \textbf{Go} enthusiast`,
+		`\textbf{ Staff Engineer } at Fintech Inc \hfill Jul 2021 - Present`,
+		"Impact: Cut latency:\n\\begin{itemize}\n\\item checkout by \\textbf{40\\%}",
+		`at R\&D\_Lab \#1 \hfill Feb 2016 - Jun 2021`,
+		`\vspace{1em}`,
+	} {
+		if !strings.Contains(source, expected) {
+			t.Errorf("Expected LaTeX to contain %q, got:\n%s", expected, source)
+		}
+	}
+}
+
+func TestGenerateLaTeX_EscapesPartials(t *testing.T) {
+	service := NewTemplateService().WithPartials(Partials{"header": `\name{ {{.Name}} }`})
+
+	source, err := service.GenerateLaTeX(`{{template "header" .}}`, models.Resume{Name: `50% \& co`})
+	if err != nil {
+		t.Fatalf("GenerateLaTeX() error = %v", err)
+	}
+	if expected := `\name{ 50\% \textbackslash{}\& co }`; source != expected {
+		t.Errorf("Expected %q, got %q", expected, source)
+	}
+}
+
+func TestGenerateLaTeX_Errors(t *testing.T) {
+	service := NewTemplateService()
+	if _, err := service.GenerateLaTeX(`{{.Name`, models.Resume{}); err == nil || !strings.Contains(err.Error(), "Template parse error") {
+		t.Errorf("Expected a parse error, got %v", err)
+	}
+	if _, err := service.GenerateLaTeX(`{{.Missing}}`, models.Resume{}); err == nil || !strings.Contains(err.Error(), "Template execution error") {
+		t.Errorf("Expected an execution error, got %v", err)
+	}
+}
+
+func TestValidateTemplateFormat_LaTeX(t *testing.T) {
+	service := NewTemplateService()
+	samples := []ValidationSample{{Name: "resume", Data: exportTestResume()}}
+
+	validation := service.ValidateTemplateFormat(testLaTeXTemplate, models.TemplateFormatLaTeX, samples)
+	if !validation.Valid {
+		t.Errorf("Expected the template to be valid, got %+v", validation.Issues)
+	}
+
+	validation = service.ValidateTemplateFormat(`{{range .WorkExperiences}}{{.Title}}{{end}}`, models.TemplateFormatLaTeX, samples)
+	if validation.Valid || len(validation.Issues) != 1 || validation.Issues[0].Kind != IssueField {
+		t.Errorf("Expected a field issue, got %+v", validation.Issues)
+	}
+}
+
+func TestLaTeXFileName(t *testing.T) {
+	if name := LaTeXFileName(models.Resume{}); name != "resume.tex" {
+		t.Errorf("Expected resume.tex, got %s", name)
+	}
+	if name := LaTeXFileName(models.CoverLetter{}); name != "cover-letter.tex" {
+		t.Errorf("Expected cover-letter.tex, got %s", name)
+	}
+}
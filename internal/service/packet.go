@@ -0,0 +1,82 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+)
+
+func init() {
+	// pdfcpu would otherwise create its configuration directory in the user's home,
+	// which fails on read-only container filesystems
+	model.ConfigPath = "disable"
+}
+
+// PacketDocument is a document of an application packet rendered with its own template
+type PacketDocument struct {
+	// Title is the bookmark of the document in the merged PDF
+	Title    string
+	Template string
+	CSS      string
+	// Data is what the template is executed with, a models.Resume or a models.CoverLetter
+	Data any
+}
+
+// PacketFileName is the file name offered when downloading an application packet
+const PacketFileName = "application-packet.pdf"
+
+// GeneratePacketPDF renders every document to PDF and merges them in order into a single PDF
+// with a bookmark pointing at the first page of each document.
+func (s *TemplateService) GeneratePacketPDF(ctx context.Context, documents []PacketDocument, timeout time.Duration) ([]byte, error) {
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("application packet has no documents")
+	}
+
+	pdfs := make([][]byte, 0, len(documents))
+	titles := make([]string, 0, len(documents))
+	for i, document := range documents {
+		pdf, err := s.GeneratePDFWithProgress(ctx, document.Template, document.CSS, document.Data, timeout, nil)
+		if err != nil {
+			return nil, fmt.Errorf("document %d (%s): %w", i+1, document.Title, err)
+		}
+		pdfs = append(pdfs, pdf)
+		titles = append(titles, document.Title)
+	}
+
+	return mergePDFs(pdfs, titles)
+}
+
+// mergePDFs concatenates the PDFs and bookmarks the first page of each with its title
+func mergePDFs(pdfs [][]byte, titles []string) ([]byte, error) {
+	conf := model.NewDefaultConfiguration()
+
+	readers := make([]io.ReadSeeker, 0, len(pdfs))
+	bookmarks := make([]pdfcpu.Bookmark, 0, len(pdfs))
+	page := 1
+	for i, pdf := range pdfs {
+		pageCount, err := api.PageCount(bytes.NewReader(pdf), conf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read document %d: %w", i+1, err)
+		}
+		readers = append(readers, bytes.NewReader(pdf))
+		bookmarks = append(bookmarks, pdfcpu.Bookmark{Title: titles[i], PageFrom: page})
+		page += pageCount
+	}
+
+	var merged bytes.Buffer
+	if err := api.MergeRaw(readers, &merged, false, conf); err != nil {
+		return nil, fmt.Errorf("failed to merge documents: %w", err)
+	}
+
+	var bookmarked bytes.Buffer
+	if err := api.AddBookmarks(bytes.NewReader(merged.Bytes()), &bookmarked, bookmarks, true, conf); err != nil {
+		return nil, fmt.Errorf("failed to add bookmarks: %w", err)
+	}
+	return bookmarked.Bytes(), nil
+}
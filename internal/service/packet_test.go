@@ -0,0 +1,91 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+)
+
+// demoPDF builds a single page PDF without a browser
+func demoPDF(t *testing.T) []byte {
+	t.Helper()
+
+	xRefTable, err := pdfcpu.CreateDemoXRef()
+	if err != nil {
+		t.Fatalf("failed to create xref table: %v", err)
+	}
+	rootDict, err := xRefTable.Catalog()
+	if err != nil {
+		t.Fatalf("failed to get catalog: %v", err)
+	}
+	page := model.Page{MediaBox: types.RectForFormat("A4"), Fm: model.FontMap{}, Buf: new(bytes.Buffer)}
+	pdfcpu.CreateTestPageContent(page)
+	if err := pdfcpu.AddPageTreeWithSamplePage(xRefTable, rootDict, page); err != nil {
+		t.Fatalf("failed to add page: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := api.WriteContext(pdfcpu.CreateContext(xRefTable, nil), &buf); err != nil {
+		t.Fatalf("failed to write PDF: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestMergePDFs(t *testing.T) {
+	first := demoPDF(t)
+	second := demoPDF(t)
+
+	merged, err := mergePDFs([][]byte{first, second, first}, []string{"Resume", "Cover Letter", "Portfolio"})
+	if err != nil {
+		t.Fatalf("mergePDFs() error = %v", err)
+	}
+
+	conf := model.NewDefaultConfiguration()
+	pageCount, err := api.PageCount(bytes.NewReader(merged), conf)
+	if err != nil {
+		t.Fatalf("failed to count pages: %v", err)
+	}
+	if pageCount != 3 {
+		t.Errorf("merged PDF has %d pages, want 3", pageCount)
+	}
+
+	bookmarks, err := api.Bookmarks(bytes.NewReader(merged), conf)
+	if err != nil {
+		t.Fatalf("failed to read bookmarks: %v", err)
+	}
+	if len(bookmarks) != 3 {
+		t.Fatalf("merged PDF has %d bookmarks, want 3", len(bookmarks))
+	}
+	for i, want := range []struct {
+		title string
+		page  int
+	}{{"Resume", 1}, {"Cover Letter", 2}, {"Portfolio", 3}} {
+		if bookmarks[i].Title != want.title || bookmarks[i].PageFrom != want.page {
+			t.Errorf("bookmark %d = %q on page %d, want %q on page %d", i, bookmarks[i].Title, bookmarks[i].PageFrom, want.title, want.page)
+		}
+	}
+}
+
+func TestMergePDFs_InvalidDocument(t *testing.T) {
+	if _, err := mergePDFs([][]byte{demoPDF(t), []byte("not a pdf")}, []string{"Resume", "Broken"}); err == nil {
+		t.Error("expected error for invalid document")
+	}
+}
+
+func TestTemplateService_GeneratePacketPDF_Errors(t *testing.T) {
+	s := NewTemplateService()
+
+	if _, err := s.GeneratePacketPDF(context.Background(), nil, DefaultPDFTimeout); err == nil {
+		t.Error("expected error for empty packet")
+	}
+
+	documents := []PacketDocument{{Title: "Resume", Template: "{{.Name", Data: nil}}
+	if _, err := s.GeneratePacketPDF(context.Background(), documents, DefaultPDFTimeout); err == nil {
+		t.Error("expected error for invalid template")
+	}
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template/parse"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// Partials maps partial names to their template source. Templates include a partial with
+// {{template "name" .}}. A partial used as a base layout declares overridable sections with
+// {{block "section" .}}default{{end}}, which the including template replaces with {{define "section"}}.
+type Partials map[string]string
+
+// rootTemplateName is the name templates are parsed under, it can't be used by partials
+const rootTemplateName = "resume"
+
+var partialNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// ValidatePartialName checks that name can be used as a partial name
+func ValidatePartialName(name string) error {
+	if !partialNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid partial name %q: must start with a letter and contain only letters, digits, - and _", name)
+	}
+	if name == rootTemplateName {
+		return fmt.Errorf("invalid partial name %q: reserved", name)
+	}
+	return nil
+}
+
+// WithPartials returns a template service that resolves the partials when parsing templates
+func (s *TemplateService) WithPartials(partials Partials) *TemplateService {
+	return &TemplateService{partials: partials}
+}
+
+// ValidatePartial checks the syntax of a partial and that it doesn't include itself through other partials.
+// References to templates that don't exist yet are allowed, they may be sections defined by the including template.
+func ValidatePartial(name, source string, partials Partials) error {
+	all := Partials{}
+	for partialName, partialSource := range partials {
+		all[partialName] = partialSource
+	}
+	all[name] = source
+
+	r := &partialResolver{partials: all, loaded: map[string]map[string]*parse.Tree{}, blocks: map[string]*parse.Tree{}, allowMissing: true}
+	trees, err := r.load(name)
+	if err != nil {
+		return err
+	}
+	return r.walk(trees[name], name, []string{name}, map[string]bool{})
+}
+
+// resolvePartials checks that every template the source references exists and that partials aren't
+// recursive, and returns the names of the partials it uses in a stable order
+func resolvePartials(source string, partials Partials) ([]string, error) {
+	rootTrees, err := parseTrees(rootTemplateName, source)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &partialResolver{partials: partials, root: rootTrees, loaded: map[string]map[string]*parse.Tree{}, blocks: map[string]*parse.Tree{}}
+	if err := r.walk(rootTrees[rootTemplateName], rootTemplateName, nil, map[string]bool{}); err != nil {
+		return nil, err
+	}
+
+	used := make([]string, 0, len(r.loaded))
+	for name := range r.loaded {
+		used = append(used, name)
+	}
+	sort.Strings(used)
+	return used, nil
+}
+
+// TemplatesIncludingPartial returns the templates that include the partial, directly or through other partials.
+// Templates that don't parse can't be checked and are left out.
+func TemplatesIncludingPartial(templates []models.Template, name string, partials Partials) []models.Template {
+	var including []models.Template
+	for _, template := range templates {
+		rootTrees, err := parseTrees(rootTemplateName, template.TemplateData)
+		if err != nil {
+			continue
+		}
+		r := &partialResolver{partials: partials, root: rootTrees, loaded: map[string]map[string]*parse.Tree{}, blocks: map[string]*parse.Tree{}, allowMissing: true}
+		// Recursive partials end the walk, the partials included until then are loaded
+		_ = r.walk(rootTrees[rootTemplateName], rootTemplateName, nil, map[string]bool{})
+		if _, ok := r.loaded[name]; ok {
+			including = append(including, template)
+		}
+	}
+	return including
+}
+
+// partialResolver follows the template references of a template through the partials.
+// Names resolve to the template's own definitions first, then to partials, then to the blocks of loaded partials.
+type partialResolver struct {
+	partials     Partials
+	root         map[string]*parse.Tree
+	loaded       map[string]map[string]*parse.Tree
+	blocks       map[string]*parse.Tree
+	allowMissing bool
+}
+
+// load parses the partial and registers the sections it defines
+func (r *partialResolver) load(name string) (map[string]*parse.Tree, error) {
+	if trees, ok := r.loaded[name]; ok {
+		return trees, nil
+	}
+	trees, err := parseTrees(name, r.partials[name])
+	if err != nil {
+		return nil, fmt.Errorf("partial %q: %w", name, err)
+	}
+	for section, tree := range trees {
+		if section == name {
+			continue
+		}
+		if _, isPartial := r.partials[section]; isPartial {
+			return nil, fmt.Errorf("partial %q defines %q, which is the name of a partial", name, section)
+		}
+		r.blocks[section] = tree
+	}
+	r.loaded[name] = trees
+	return trees, nil
+}
+
+// lookup returns the tree a template reference resolves to and whether it is a partial
+func (r *partialResolver) lookup(name string) (*parse.Tree, bool, error) {
+	if tree, ok := r.root[name]; ok {
+		return tree, false, nil
+	}
+	if _, ok := r.partials[name]; ok {
+		trees, err := r.load(name)
+		if err != nil {
+			return nil, false, err
+		}
+		return trees[name], true, nil
+	}
+	if tree, ok := r.blocks[name]; ok {
+		return tree, false, nil
+	}
+	return nil, false, nil
+}
+
+// walk follows the references of the tree. path holds the partials being included, so a partial
+// appearing twice on it includes itself. Recursion between the template's own definitions is allowed.
+func (r *partialResolver) walk(tree *parse.Tree, name string, path []string, visited map[string]bool) error {
+	if tree == nil || tree.Root == nil {
+		return nil
+	}
+	for _, reference := range templateReferences(tree.Root) {
+		target, isPartial, err := r.lookup(reference)
+		if err != nil {
+			return err
+		}
+		if target == nil {
+			if r.allowMissing {
+				continue
+			}
+			return fmt.Errorf("%s references partial %q, which doesn't exist", describeTemplate(name), reference)
+		}
+
+		if isPartial {
+			for i, included := range path {
+				if included == reference {
+					cycle := append(append([]string{}, path[i:]...), reference)
+					return fmt.Errorf("partial %q is recursive: %s", reference, strings.Join(cycle, " -> "))
+				}
+			}
+			if err := r.walk(target, reference, append(path, reference), visited); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Sections are walked once per partial path, which also stops recursive sections
+		key := strings.Join(path, "/") + ":" + reference
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+		if err := r.walk(target, reference, path, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func describeTemplate(name string) string {
+	if name == rootTemplateName {
+		return "template"
+	}
+	return fmt.Sprintf("%q", name)
+}
+
+// parseTrees parses the source into its main tree and the trees of the sections it defines
+func parseTrees(name, source string) (map[string]*parse.Tree, error) {
+	trees := map[string]*parse.Tree{}
+	tree := parse.New(name)
+	tree.Mode = parse.SkipFuncCheck
+	if _, err := tree.Parse(source, "", "", trees); err != nil {
+		return nil, err
+	}
+	return trees, nil
+}
+
+// templateReferences returns the names of the templates the node includes, in order of appearance
+func templateReferences(node parse.Node) []string {
+	var names []string
+	var visit func(parse.Node)
+	visit = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				visit(child)
+			}
+		case *parse.IfNode:
+			visit(n.List)
+			visit(n.ElseList)
+		case *parse.RangeNode:
+			visit(n.List)
+			visit(n.ElseList)
+		case *parse.WithNode:
+			visit(n.List)
+			visit(n.ElseList)
+		case *parse.TemplateNode:
+			names = append(names, n.Name)
+		}
+	}
+	visit(node)
+	return names
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func TestTemplateService_Partials(t *testing.T) {
+	partials := Partials{
+		"header":   `<header>{{.Name}}{{template "contacts" .}}</header>`,
+		"contacts": `{{range .Contacts}}<span>{{.Value}}</span>{{end}}`,
+		"base":     `<main>{{template "header" .}}{{block "content" .}}<p>default content</p>{{end}}<footer>{{block "footer" .}}base footer{{end}}</footer></main>`,
+	}
+	resume := models.Resume{
+		Name:     "Jane Doe",
+		Contacts: []models.Contact{{Key: "email", Value: "jane@example.com"}},
+	}
+	service := NewTemplateService().WithPartials(partials)
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"include partial", `{{template "header" .}}`, "<header>Jane Doe<span>jane@example.com</span></header>"},
+		{"extend layout", `{{template "base" .}}{{define "content"}}<p>{{.Name}} content</p>{{end}}`, "<main><header>Jane Doe<span>jane@example.com</span></header><p>Jane Doe content</p><footer>base footer</footer></main>"},
+		{"layout defaults", `{{template "base" .}}`, "<p>default content</p>"},
+		{"own definitions win", `{{define "header"}}<h1>own</h1>{{end}}{{template "header" .}}`, "<h1>own</h1>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, err := service.GeneratePreview(tt.template, "", resume)
+			if err != nil {
+				t.Fatalf("GeneratePreview() error = %v", err)
+			}
+			if !strings.Contains(html, tt.expected) {
+				t.Errorf("Expected %q in output, got %s", tt.expected, html)
+			}
+		})
+	}
+}
+
+func TestTemplateService_PartialErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		partials    Partials
+		template    string
+		errContains string
+	}{
+		{
+			name:        "missing partial",
+			template:    `{{if .Name}}{{template "header" .}}{{end}}`,
+			errContains: `references partial "header", which doesn't exist`,
+		},
+		{
+			name:        "missing nested partial",
+			partials:    Partials{"header": `{{template "contacts" .}}`},
+			template:    `{{template "header" .}}`,
+			errContains: `"header" references partial "contacts"`,
+		},
+		{
+			name:        "recursive partial",
+			partials:    Partials{"a": `{{template "b" .}}`, "b": `{{range .Contacts}}{{template "a" .}}{{end}}`},
+			template:    `{{template "a" .}}`,
+			errContains: `partial "a" is recursive: a -> b -> a`,
+		},
+		{
+			name:        "partial including itself",
+			partials:    Partials{"a": `{{template "a" .}}`},
+			template:    `{{template "a" .}}`,
+			errContains: "is recursive",
+		},
+		{
+			name:        "recursion through a section",
+			partials:    Partials{"base": `{{block "content" .}}{{end}}`},
+			template:    `{{template "base" .}}{{define "content"}}{{template "base" .}}{{end}}`,
+			errContains: `partial "base" is recursive`,
+		},
+		{
+			name:        "partial syntax error",
+			partials:    Partials{"header": `{{if .Name}}`},
+			template:    `{{template "header" .}}`,
+			errContains: `partial "header"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTemplateService().WithPartials(tt.partials).GeneratePreview(tt.template, "", models.Resume{Name: "Jane"})
+			if err == nil {
+				t.Fatal("Expected error")
+			}
+			if !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("Expected error containing %q, got %v", tt.errContains, err)
+			}
+		})
+	}
+}
+
+func TestValidatePartial(t *testing.T) {
+	partials := Partials{"b": `{{template "a" .}}`}
+
+	if err := ValidatePartial("a", `<p>{{template "section" .}}</p>`, partials); err != nil {
+		t.Errorf("Expected sections defined by the including template to be allowed, got %v", err)
+	}
+	if err := ValidatePartial("a", `{{template "b" .}}`, partials); err == nil || !strings.Contains(err.Error(), "a -> b -> a") {
+		t.Errorf("Expected recursion error, got %v", err)
+	}
+	if err := ValidatePartial("a", `{{end}}`, nil); err == nil {
+		t.Error("Expected syntax error")
+	}
+	if err := ValidatePartial("a", `{{define "b"}}x{{end}}`, partials); err == nil {
+		t.Error("Expected error for a section named like a partial")
+	}
+}
+
+func TestTemplatesIncludingPartial(t *testing.T) {
+	partials := Partials{
+		"header": `<h1>{{.Name}}</h1>`,
+		"base":   `<main>{{template "header" .}}{{block "content" .}}{{end}}</main>`,
+		"footer": `<footer></footer>`,
+	}
+	templates := []models.Template{
+		{ID: 1, TemplateData: `{{template "header" .}}`},
+		{ID: 2, TemplateData: `{{template "base" .}}{{define "content"}}<p></p>{{end}}`},
+		{ID: 3, TemplateData: `{{template "footer" .}}`},
+		{ID: 4, TemplateData: `{{template "missing" .}}{{template "header" .}}`},
+		{ID: 5, TemplateData: `{{if .Name}}`},
+	}
+
+	var ids []uint
+	for _, template := range TemplatesIncludingPartial(templates, "header", partials) {
+		ids = append(ids, template.ID)
+	}
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 4 {
+		t.Errorf("Expected templates 1, 2 and 4 to include the header, got %v", ids)
+	}
+
+	if including := TemplatesIncludingPartial(templates, "unused", partials); len(including) != 0 {
+		t.Errorf("Expected no templates to include an unknown partial, got %+v", including)
+	}
+}
+
+func TestValidatePartialName(t *testing.T) {
+	for _, name := range []string{"header", "Base_layout-2"} {
+		if err := ValidatePartialName(name); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "2col", "my header", "resume", "a/b"} {
+		if err := ValidatePartialName(name); err == nil {
+			t.Errorf("Expected %q to be invalid", name)
+		}
+	}
+}
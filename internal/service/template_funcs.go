@@ -0,0 +1,428 @@
+package service
+
+import (
+	"fmt"
+	"html/template"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/analysis"
+	"github.com/rxtech-lab/resume-mcp/internal/markdown"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// datedEntry is a resume entry with a partial date range, a work experience or an education
+type datedEntry interface {
+	Start() models.PartialDate
+	End() models.PartialDate
+	Current() bool
+}
+
+// presentLabel is printed by dateRange for the end of ongoing entries
+const presentLabel = "Present"
+
+// TemplateFunction documents a function available to templates. Functions taking a list take it
+// as their last argument, so they can be used in pipelines, e.g. {{range .WorkExperiences | sortByDate "desc"}}.
+type TemplateFunction struct {
+	Name        string `json:"name"`
+	Signature   string `json:"signature"`
+	Description string `json:"description"`
+	Example     string `json:"example"`
+	fn          any
+}
+
+// Group is a group of list items sharing a key, as returned by groupBy
+type Group struct {
+	Key   string
+	Items []any
+}
+
+// templateFunctions are the functions available to all templates
+var templateFunctions = []TemplateFunction{
+	{
+		Name:        "timeline",
+		Signature:   "timeline resume",
+		Description: "Computes experience statistics of the resume: TotalYears, EducationYears, YearsByType, Gaps, Skills and Entries",
+		Example:     "{{with timeline .}}{{.TotalYears}} years of experience{{end}}",
+		fn: func(resume models.Resume) analysis.Timeline {
+			return analysis.BuildTimeline(&resume, time.Now())
+		},
+	},
+	{
+		Name:        "formatDate",
+		Signature:   "formatDate date layout",
+		Description: "Formats a date with a Go layout, leaving out the parts of year and month precision dates that aren't known. Empty for missing dates",
+		Example:     `{{formatDate .Start "Jan 2, 2006"}} prints "Mar 2020" for a month precision date`,
+		fn:          formatDate,
+	},
+	{
+		Name:        "dateRange",
+		Signature:   "dateRange entry layout",
+		Description: "Prints the dates of a work experience or education, with Present as the end of ongoing entries",
+		Example:     `{{dateRange . "Jan 2006"}} prints "Mar 2020 - Present" for a current role`,
+		fn: func(entry datedEntry, layout string) string {
+			end := presentLabel
+			if !entry.Current() {
+				end = entry.End().Format(layout)
+			}
+			return entry.Start().Format(layout) + " - " + end
+		},
+	},
+	{
+		Name:        "duration",
+		Signature:   "duration entry",
+		Description: "Prints how long a work experience or education lasted, up to today for ongoing entries",
+		Example:     `{{duration .}} prints "2 yrs 3 mos"`,
+		fn: func(entry datedEntry) string {
+			return duration(entry, time.Now())
+		},
+	},
+	{
+		Name:        "sortByDate",
+		Signature:   "sortByDate order list",
+		Description: `Sorts work experiences or educations by start date, "asc" for oldest first or "desc" for newest first. Ongoing entries come first among entries starting together`,
+		Example:     `{{range .WorkExperiences | sortByDate "desc"}}{{.Company}}{{end}}`,
+		fn:          sortByDate,
+	},
+	{
+		Name:        "groupBy",
+		Signature:   "groupBy field list",
+		Description: "Groups list items by a field, given by its Go or JSON name. Groups keep the order in which their key first appears and have a Key and the Items",
+		Example:     `{{range .FeatureMaps | groupBy "Category"}}<h3>{{.Key}}</h3>{{range .Items}}<p>{{.Value}}</p>{{end}}{{end}}`,
+		fn:          groupBy,
+	},
+	{
+		Name:        "pluck",
+		Signature:   "pluck field list",
+		Description: "Returns a field of every list item, given by its Go or JSON name",
+		Example:     `{{.FeatureMaps | pluck "Value" | join ", "}}`,
+		fn:          pluck,
+	},
+	{
+		Name:        "join",
+		Signature:   "join separator list",
+		Description: "Joins the list items with the separator",
+		Example:     `{{join ", " (pluck "Company" .WorkExperiences)}}`,
+		fn:          join,
+	},
+	{
+		Name:        "limit",
+		Signature:   "limit n list",
+		Description: "Returns the first n list items",
+		Example:     `{{range .WorkExperiences | sortByDate "desc" | limit 3}}{{.JobTitle}}{{end}}`,
+		fn:          limit,
+	},
+	{
+		Name:        "default",
+		Signature:   "default fallback value",
+		Description: "Returns the fallback when the value is empty",
+		Example:     `{{.Photo | default "https://example.com/avatar.png"}}`,
+		fn: func(fallback, value any) any {
+			if isEmpty(value) {
+				return fallback
+			}
+			return value
+		},
+	},
+	{
+		Name:        "markdown",
+		Signature:   "markdown text",
+		Description: "Renders Markdown, e.g. bold text, links and nested bullets in feature map values, as HTML. Raw HTML and unsafe links are dropped",
+		Example:     "{{range .FeatureMaps}}<div>{{markdown .Value}}</div>{{end}}",
+		fn:          markdown.ToHTML,
+	},
+	{
+		Name:        "plainText",
+		Signature:   "plainText text",
+		Description: "Strips Markdown formatting, keeping list markers and printing links as text (URL)",
+		Example:     `<meta name="description" content="{{plainText .Description}}">`,
+		fn:          markdown.ToText,
+	},
+	{
+		Name:        "indent",
+		Signature:   "indent n text",
+		Description: "Indents every line of the text but the first by n spaces, e.g. to continue a list item over several lines in Markdown and plain text exports",
+		Example:     "- {{indent 2 .Value}}",
+		fn:          indent,
+	},
+	{
+		Name:        "upper",
+		Signature:   "upper text",
+		Description: "Converts the text to upper case",
+		Example:     "{{upper .Name}}",
+		fn:          strings.ToUpper,
+	},
+	{
+		Name:        "lower",
+		Signature:   "lower text",
+		Description: "Converts the text to lower case",
+		Example:     "{{lower .Type}}",
+		fn:          strings.ToLower,
+	},
+	{
+		Name:        "title",
+		Signature:   "title text",
+		Description: "Capitalizes the first letter of every word",
+		Example:     `{{title .Category}} prints "Open Source" for "open source"`,
+		fn:          title,
+	},
+	{
+		Name:        "trim",
+		Signature:   "trim text",
+		Description: "Removes leading and trailing white space",
+		Example:     "{{trim .Description}}",
+		fn:          strings.TrimSpace,
+	},
+}
+
+// TemplateFunctions returns the documentation of the functions available to templates
+func TemplateFunctions() []TemplateFunction {
+	functions := make([]TemplateFunction, len(templateFunctions))
+	copy(functions, templateFunctions)
+	return functions
+}
+
+// templateFuncs returns the functions available to resume templates
+func templateFuncs() template.FuncMap {
+	funcs := make(template.FuncMap, len(templateFunctions))
+	for _, function := range templateFunctions {
+		funcs[function.Name] = function.fn
+	}
+	return funcs
+}
+
+// formatDate formats partial dates by their precision and plain dates with the layout as is
+func formatDate(date any, layout string) (string, error) {
+	switch d := date.(type) {
+	case models.PartialDate:
+		return d.Format(layout), nil
+	case time.Time:
+		if d.IsZero() {
+			return "", nil
+		}
+		return d.Format(layout), nil
+	case *time.Time:
+		if d == nil || d.IsZero() {
+			return "", nil
+		}
+		return d.Format(layout), nil
+	case nil:
+		return "", nil
+	}
+	return "", fmt.Errorf("formatDate: unsupported date type %T", date)
+}
+
+// duration prints the whole months from the entry's start to the end of its end date's period,
+// e.g. 3 yrs from 2019 to 2021, or to now for ongoing entries
+func duration(entry datedEntry, now time.Time) string {
+	start := entry.Start().Time
+	end := now
+	if !entry.Current() {
+		end = entry.End().EndOfPeriod()
+	}
+
+	months := (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month())
+	if end.Day() < start.Day() {
+		months--
+	}
+	if months < 1 {
+		return "less than 1 mo"
+	}
+
+	var parts []string
+	if years := months / 12; years > 0 {
+		parts = append(parts, plural(years, "yr", "yrs"))
+	}
+	if months%12 > 0 {
+		parts = append(parts, plural(months%12, "mo", "mos"))
+	}
+	return strings.Join(parts, " ")
+}
+
+func plural(n int, singular, pluralForm string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+	return fmt.Sprintf("%d %s", n, pluralForm)
+}
+
+// sortByDate returns a sorted copy of a slice of work experiences or educations
+func sortByDate(order string, list any) (any, error) {
+	if order != "asc" && order != "desc" {
+		return nil, fmt.Errorf("sortByDate: order must be asc or desc, got %q", order)
+	}
+	items, err := listValue("sortByDate", list)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]datedEntry, items.Len())
+	for i := range entries {
+		entry, ok := items.Index(i).Interface().(datedEntry)
+		if !ok {
+			return nil, fmt.Errorf("sortByDate: %s has no dates", items.Index(i).Type())
+		}
+		entries[i] = entry
+	}
+
+	sorted := reflect.MakeSlice(items.Type(), items.Len(), items.Len())
+	reflect.Copy(sorted, items)
+	indexes := make([]int, items.Len())
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := entries[indexes[i]], entries[indexes[j]]
+		if !a.Start().Time.Equal(b.Start().Time) {
+			if order == "asc" {
+				return a.Start().Time.Before(b.Start().Time)
+			}
+			return a.Start().Time.After(b.Start().Time)
+		}
+		return a.Current() && !b.Current()
+	})
+	for i, index := range indexes {
+		sorted.Index(i).Set(items.Index(index))
+	}
+	return sorted.Interface(), nil
+}
+
+// groupBy groups list items by a field, in the order their keys first appear
+func groupBy(field string, list any) ([]Group, error) {
+	items, err := listValue("groupBy", list)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []Group
+	positions := map[string]int{}
+	for i := 0; i < items.Len(); i++ {
+		value, err := fieldValue("groupBy", items.Index(i), field)
+		if err != nil {
+			return nil, err
+		}
+		key := fmt.Sprint(value)
+		position, ok := positions[key]
+		if !ok {
+			position = len(groups)
+			positions[key] = position
+			groups = append(groups, Group{Key: key})
+		}
+		groups[position].Items = append(groups[position].Items, items.Index(i).Interface())
+	}
+	return groups, nil
+}
+
+// pluck returns a field of every list item
+func pluck(field string, list any) ([]any, error) {
+	items, err := listValue("pluck", list)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]any, 0, items.Len())
+	for i := 0; i < items.Len(); i++ {
+		value, err := fieldValue("pluck", items.Index(i), field)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// join joins the list items printed with fmt.Sprint
+func join(separator string, list any) (string, error) {
+	items, err := listValue("join", list)
+	if err != nil {
+		return "", err
+	}
+
+	parts := make([]string, items.Len())
+	for i := range parts {
+		parts[i] = fmt.Sprint(items.Index(i).Interface())
+	}
+	return strings.Join(parts, separator), nil
+}
+
+// limit returns the first n list items
+func limit(n int, list any) (any, error) {
+	items, err := listValue("limit", list)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("limit: n must not be negative, got %d", n)
+	}
+	return items.Slice(0, min(n, items.Len())).Interface(), nil
+}
+
+// indent adds n spaces after every line break, so multi-line values stay nested in Markdown lists
+func indent(n int, text string) string {
+	return strings.ReplaceAll(text, "\n", "\n"+strings.Repeat(" ", n))
+}
+
+// title capitalizes the first letter of every space separated word
+func title(text string) string {
+	words := strings.Split(text, " ")
+	for i, word := range words {
+		for _, r := range word {
+			words[i] = strings.ToUpper(string(r)) + word[len(string(r)):]
+			break
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// listValue returns the slice or array argument of the named function, nil counts as an empty list
+func listValue(function string, list any) (reflect.Value, error) {
+	if list == nil {
+		return reflect.ValueOf([]any{}), nil
+	}
+	items := reflect.ValueOf(list)
+	if items.Kind() != reflect.Slice && items.Kind() != reflect.Array {
+		return reflect.Value{}, fmt.Errorf("%s: expected a list, got %T", function, list)
+	}
+	return items, nil
+}
+
+// fieldValue returns the field of a struct list item by its Go name or its JSON name
+func fieldValue(function string, item reflect.Value, field string) (any, error) {
+	for item.Kind() == reflect.Pointer || item.Kind() == reflect.Interface {
+		if item.IsNil() {
+			return nil, fmt.Errorf("%s: list contains nil", function)
+		}
+		item = item.Elem()
+	}
+	if item.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s: list items of type %s have no fields", function, item.Type())
+	}
+
+	if value := item.FieldByName(field); value.IsValid() && value.CanInterface() {
+		return value.Interface(), nil
+	}
+	for i := 0; i < item.NumField(); i++ {
+		name, _, _ := strings.Cut(item.Type().Field(i).Tag.Get("json"), ",")
+		if name == field && item.Field(i).CanInterface() {
+			return item.Field(i).Interface(), nil
+		}
+	}
+	return nil, fmt.Errorf("%s: %s has no field %q", function, item.Type(), field)
+}
+
+// isEmpty reports whether the value is empty the way template conditions consider it false
+func isEmpty(value any) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.String:
+		return v.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	}
+	return v.IsZero()
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func templateFunctionsResume() models.Resume {
+	endDate := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	schoolEnd := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	return models.Resume{
+		Name:     "jane doe",
+		Contacts: []models.Contact{{Key: "email", Value: "jane@example.com"}},
+		WorkExperiences: []models.WorkExperience{
+			{
+				Company: "Bank Corp", JobTitle: "Engineer", Type: "fulltime",
+				StartDate: time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), StartDatePrecision: models.DatePrecisionMonth,
+				EndDate: &endDate, EndDatePrecision: models.DatePrecisionMonth,
+			},
+			{Company: "Startup", JobTitle: "Lead", Type: "fulltime", StartDate: time.Date(2021, 7, 15, 0, 0, 0, 0, time.UTC)},
+			{Company: "Agency", JobTitle: "Intern", Type: "internship", StartDate: time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC), EndDate: &endDate},
+		},
+		Educations: []models.Education{
+			{
+				SchoolName: "State University",
+				StartDate:  time.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC), StartDatePrecision: models.DatePrecisionYear,
+				EndDate: &schoolEnd, EndDatePrecision: models.DatePrecisionYear,
+			},
+		},
+		OtherExperiences: []models.OtherExperience{
+			{
+				Category: "Skills",
+				FeatureMaps: []models.FeatureMap{
+					{Key: "Go", Value: "5 years", Category: "languages"},
+					{Key: "Postgres", Value: "4 years", Category: "databases"},
+					{Key: "Python", Value: "3 years", Category: "languages"},
+				},
+			},
+		},
+	}
+}
+
+func TestTemplateFunctions(t *testing.T) {
+	service := NewTemplateService()
+	resume := templateFunctionsResume()
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"sort newest first", `{{range .WorkExperiences | sortByDate "desc"}}[{{.Company}}]{{end}}`, "[Startup][Bank Corp][Agency]"},
+		{"sort oldest first", `{{range sortByDate "asc" .WorkExperiences}}[{{.Company}}]{{end}}`, "[Agency][Bank Corp][Startup]"},
+		{"group by json name", `{{with index .OtherExperiences 0}}{{range .FeatureMaps | groupBy "category"}}[{{.Key}}:{{range .Items}}{{.Key}};{{end}}]{{end}}{{end}}`, "[languages:Go;Python;][databases:Postgres;]"},
+		{"group by go name", `{{range .WorkExperiences | groupBy "Type"}}{{.Key}}={{len .Items}} {{end}}`, "fulltime=2 internship=1"},
+		{"pluck and join", `{{.WorkExperiences | pluck "Company" | join ", "}}`, "Bank Corp, Startup, Agency"},
+		{"limit", `{{range .WorkExperiences | sortByDate "desc" | limit 2}}[{{.JobTitle}}]{{end}}`, "[Lead][Engineer]"},
+		{"limit beyond length", `{{len (limit 10 .Educations)}}`, "1"},
+		{"duration months", `{{duration (index .WorkExperiences 0)}}`, "2 yrs 4 mos"},
+		{"duration years", `{{duration (index .Educations 0)}}`, "5 yrs"},
+		{"case", `{{upper "go"}} {{lower "SQL"}} {{title .Name}}`, "GO sql Jane Doe"},
+		{"trim", `[{{trim "  spaced  "}}]`, "[spaced]"},
+		{"default", `{{.Photo | default "none"}}/{{.Name | default "none"}}`, "none/jane doe"},
+		{"markdown", `{{markdown "Cut latency by **40%**"}}`, "<p>Cut latency by <strong>40%</strong></p>"},
+		{"markdown drops raw html", `{{markdown "<script>alert(1)</script>"}}`, "<!-- raw HTML omitted -->"},
+		{"plain text", `{{plainText "Built [checkout](https://example.com)"}}`, "Built checkout (https://example.com)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, err := service.GeneratePreview(tt.template, "", resume)
+			if err != nil {
+				t.Fatalf("GeneratePreview() error = %v", err)
+			}
+			if !strings.Contains(html, tt.expected) {
+				t.Errorf("Expected %q in output, got %s", tt.expected, html)
+			}
+		})
+	}
+}
+
+func TestTemplateFunctions_Errors(t *testing.T) {
+	service := NewTemplateService()
+	resume := templateFunctionsResume()
+
+	templates := map[string]string{
+		"unknown sort order": `{{sortByDate "newest" .WorkExperiences}}`,
+		"sort without dates": `{{sortByDate "asc" .Contacts}}`,
+		"unknown field":      `{{groupBy "Missing" .WorkExperiences}}`,
+		"group non struct":   `{{groupBy "Key" (pluck "Company" .WorkExperiences)}}`,
+		"join non list":      `{{join ", " .Name}}`,
+		"negative limit":     `{{limit -1 .WorkExperiences}}`,
+	}
+	for name, tmpl := range templates {
+		t.Run(name, func(t *testing.T) {
+			if _, err := service.GeneratePreview(tmpl, "", resume); err == nil {
+				t.Errorf("Expected error rendering %s", tmpl)
+			}
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	now := time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)
+	start := func(year int, month time.Month, day int) models.WorkExperience {
+		return models.WorkExperience{StartDate: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+	}
+	ended := func(startYear int, startPrecision string, endYear int, endPrecision string) models.WorkExperience {
+		end := time.Date(endYear, 1, 1, 0, 0, 0, 0, time.UTC)
+		return models.WorkExperience{
+			StartDate: time.Date(startYear, 1, 1, 0, 0, 0, 0, time.UTC), StartDatePrecision: startPrecision,
+			EndDate: &end, EndDatePrecision: endPrecision,
+		}
+	}
+
+	tests := []struct {
+		entry    models.WorkExperience
+		expected string
+	}{
+		{start(2023, 5, 1), "1 yr"},
+		{start(2023, 4, 1), "1 yr 1 mo"},
+		{start(2024, 3, 1), "2 mos"},
+		{start(2024, 4, 25), "less than 1 mo"},
+		// end dates count through the end of their year or month
+		{ended(2018, models.DatePrecisionYear, 2018, models.DatePrecisionYear), "1 yr"},
+		{ended(2019, models.DatePrecisionYear, 2021, models.DatePrecisionYear), "3 yrs"},
+		{ended(2019, models.DatePrecisionYear, 2019, models.DatePrecisionMonth), "1 mo"},
+	}
+	for _, tt := range tests {
+		if got := duration(tt.entry, now); got != tt.expected {
+			t.Errorf("duration from %s to %s = %q, want %q", tt.entry.Start(), tt.entry.End(), got, tt.expected)
+		}
+	}
+}
+
+func TestTemplateFunctionsDocumented(t *testing.T) {
+	funcs := templateFuncs()
+	for _, function := range TemplateFunctions() {
+		if function.Signature == "" || function.Description == "" || function.Example == "" {
+			t.Errorf("Template function %q is not documented", function.Name)
+		}
+		if !strings.HasPrefix(function.Signature, function.Name) {
+			t.Errorf("Signature %q doesn't start with the function name %q", function.Signature, function.Name)
+		}
+		if funcs[function.Name] == nil {
+			t.Errorf("Template function %q is not registered", function.Name)
+		}
+	}
+}
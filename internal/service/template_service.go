@@ -9,9 +9,9 @@ import (
 	"os"
 	"time"
 
-	"github.com/chromedp/cdproto/page"
 	"github.com/chromedp/chromedp"
 	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/tailwind"
 )
 
 type stringBuilder struct {
@@ -28,19 +28,25 @@ func (sb *stringBuilder) String() string {
 }
 
 type TemplateService struct {
+	// partials are the partials templates can include, see WithPartials
+	partials Partials
 }
 
 func NewTemplateService() *TemplateService {
 	return &TemplateService{}
 }
 
-func (s *TemplateService) GeneratePreview(templateStr, css string, resume models.Resume) (string, error) {
-	return s.GeneratePreviewWithOptions(templateStr, css, resume, false, "")
+func (s *TemplateService) GeneratePreview(templateStr, css string, data any) (string, error) {
+	return s.GeneratePreviewWithOptions(templateStr, css, data, false, "", "")
 }
 
-func (s *TemplateService) GeneratePreviewWithOptions(templateStr, css string, resume models.Resume, includeDownloadButton bool, downloadURL string) (string, error) {
+// GeneratePreviewWithOptions renders the full preview page. data is what the template is executed
+// with, a models.Resume for resume templates or a models.CoverLetter for cover letter templates.
+// When the download button is included and eventsURL is set, the page subscribes to that
+// server-sent events stream and reloads itself whenever the resume changes.
+func (s *TemplateService) GeneratePreviewWithOptions(templateStr, css string, data any, includeDownloadButton bool, downloadURL string, eventsURL string) (string, error) {
 
-	tmpl, err := template.New("resume").Parse(templateStr)
+	tmpl, err := s.parse(templateStr)
 	if err != nil {
 		log.SetOutput(os.Stderr)
 		log.SetFlags(0)
@@ -51,7 +57,7 @@ func (s *TemplateService) GeneratePreviewWithOptions(templateStr, css string, re
 
 	var html string
 	builder := &stringBuilder{}
-	if err := tmpl.Execute(builder, resume); err != nil {
+	if err := tmpl.Execute(builder, data); err != nil {
 		log.SetOutput(os.Stderr)
 		log.SetFlags(0)
 		log.Printf("Template execution error: %v", err)
@@ -134,7 +140,7 @@ func (s *TemplateService) GeneratePreviewWithOptions(templateStr, css string, re
                 const a = document.createElement('a');
                 a.style.display = 'none';
                 a.href = url;
-                a.download = 'resume.pdf';
+                a.download = '` + PDFFileName(data) + `';
                 document.body.appendChild(a);
                 a.click();
                 window.URL.revokeObjectURL(url);
@@ -155,6 +161,25 @@ func (s *TemplateService) GeneratePreviewWithOptions(templateStr, css string, re
             }
         }
     </script>`
+
+		if eventsURL != "" {
+			appBar += `
+    <script>
+        (function () {
+            if (!window.EventSource) return;
+            const source = new EventSource('` + eventsURL + `');
+            source.addEventListener('change', function () {
+                sessionStorage.setItem('resume-preview-scroll', String(window.scrollY));
+                window.location.reload();
+            });
+            const scroll = sessionStorage.getItem('resume-preview-scroll');
+            if (scroll !== null) {
+                sessionStorage.removeItem('resume-preview-scroll');
+                window.addEventListener('load', function () { window.scrollTo(0, Number(scroll)); });
+            }
+        })();
+    </script>`
+		}
 	}
 
 	fullHTML := `<!DOCTYPE html>
@@ -163,7 +188,8 @@ func (s *TemplateService) GeneratePreviewWithOptions(templateStr, css string, re
     <meta charset="UTF-8">
     <meta name="viewport" content="width=device-width, initial-scale=1.0">
     <title>Resume Preview</title>
-    <script src="https://cdn.tailwindcss.com"></script>
+    <style>
+` + tailwind.Stylesheet(appBar+html) + `    </style>
     ` + cssStyle + `
 </head>
 <body>
@@ -175,54 +201,72 @@ func (s *TemplateService) GeneratePreviewWithOptions(templateStr, css string, re
 	return fullHTML, nil
 }
 
-func (s *TemplateService) GeneratePDF(templateStr, css string, resume models.Resume) ([]byte, error) {
-	// Generate HTML without download button
-	html, err := s.GeneratePreviewWithOptions(templateStr, css, resume, false, "")
+// DefaultPDFTimeout is the time PDF generation may take when no timeout is given
+const DefaultPDFTimeout = 30 * time.Second
+
+// Stages of PDF generation reported to a PDFProgressFunc
+const (
+	PDFStageTemplateRendered = "template rendered"
+	PDFStageBrowserAcquired  = "browser acquired"
+	PDFStagePageLoaded       = "page loaded"
+	PDFStagePDFPrinted       = "PDF printed"
+
+	PDFStageCount = 4
+)
+
+// PDFFileName is the file name offered when downloading the rendered data as PDF
+func PDFFileName(data any) string {
+	if _, ok := data.(models.CoverLetter); ok {
+		return "cover-letter.pdf"
+	}
+	return "resume.pdf"
+}
+
+// parse parses the template together with the partials it includes. The template is parsed last,
+// so its definitions replace the blocks of the layouts it extends.
+func (s *TemplateService) parse(templateStr string) (*template.Template, error) {
+	used, err := resolvePartials(templateStr, s.partials)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get remote Chrome URL from environment
-	remoteURL := os.Getenv("CHROMEDP_REMOTE_URL")
-
-	var ctx context.Context
-	var cancel context.CancelFunc
-	var allocCancel context.CancelFunc
-
-	if remoteURL != "" {
-		// Use remote Chrome instance
-		var allocCtx context.Context
-		allocCtx, allocCancel = chromedp.NewRemoteAllocator(context.Background(), remoteURL)
-		// Ensure allocator is always cancelled
-		defer func() {
-			if allocCancel != nil {
-				allocCancel()
-			}
-		}()
-		ctx, cancel = chromedp.NewContext(allocCtx)
-	} else {
-		// Use local Chrome instance
-		ctx, cancel = chromedp.NewContext(context.Background())
-	}
-	// Ensure context is always cancelled
-	defer func() {
-		if cancel != nil {
-			cancel()
+	tmpl := template.New(rootTemplateName).Funcs(templateFuncs())
+	for _, name := range used {
+		if _, err := tmpl.New(name).Parse(s.partials[name]); err != nil {
+			return nil, fmt.Errorf("partial %q: %w", name, err)
 		}
-	}()
+	}
+	return tmpl.Parse(templateStr)
+}
 
-	// Set timeout - use a separate variable to avoid shadowing cancel
-	timeoutCtx, timeoutCancel := context.WithTimeout(ctx, 30*time.Second)
-	defer timeoutCancel()
+// PDFProgressFunc is called after each completed stage of PDF generation
+type PDFProgressFunc func(stage string, step int, total int)
 
-	var pdfBuffer []byte
+func (s *TemplateService) GeneratePDF(templateStr, css string, data any) ([]byte, error) {
+	return s.GeneratePDFWithProgress(context.Background(), templateStr, css, data, DefaultPDFTimeout, nil)
+}
+
+// GeneratePDFWithProgress renders the resume to PDF and reports each stage to progress.
+// Cancelling ctx stops the browser and aborts the generation.
+func (s *TemplateService) GeneratePDFWithProgress(ctx context.Context, templateStr, css string, data any, timeout time.Duration, progress PDFProgressFunc) ([]byte, error) {
+	if progress == nil {
+		progress = func(string, int, int) {}
+	}
+	if timeout <= 0 {
+		timeout = DefaultPDFTimeout
+	}
 
-	// Navigate to data URL and generate PDF
-	err = chromedp.Run(timeoutCtx,
-		chromedp.Navigate("data:text/html,"+html),
+	// Generate HTML without download button
+	html, err := s.GeneratePreviewWithOptions(templateStr, css, data, false, "", "")
+	if err != nil {
+		return nil, err
+	}
+	progress(PDFStageTemplateRendered, 1, PDFStageCount)
+
+	var pdfBuffer []byte
+	err = s.renderInBrowser(ctx, html, timeout, progress,
 		chromedp.ActionFunc(func(ctx context.Context) error {
-			// Use page.PrintToPDF with print background enabled
-			buf, _, err := page.PrintToPDF().WithPrintBackground(true).Do(ctx)
+			buf, _, err := printToPDF().Do(ctx)
 			if err != nil {
 				return err
 			}
@@ -230,14 +274,14 @@ func (s *TemplateService) GeneratePDF(templateStr, css string, resume models.Res
 			return nil
 		}),
 	)
-
 	if err != nil {
 		log.SetOutput(os.Stderr)
 		log.SetFlags(0)
 		log.Printf("PDF generation error: %v", err)
 		log.SetOutput(io.Discard)
-		return nil, fmt.Errorf("PDF generation error: %v", err)
+		return nil, fmt.Errorf("PDF generation error: %w", err)
 	}
+	progress(PDFStagePDFPrinted, 4, PDFStageCount)
 
 	return pdfBuffer, nil
 }
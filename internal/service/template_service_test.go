@@ -1,6 +1,12 @@
 package service
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/draw"
+	"image/png"
 	"strings"
 	"testing"
 	"time"
@@ -127,7 +133,7 @@ func TestTemplateService_GeneratePreview(t *testing.T) {
 			css:         "",
 			resume:      sampleResume,
 			wantErr:     false,
-			contains:    []string{"John Doe", "Software Engineer", "<!DOCTYPE html>", "<script src=\"https://cdn.tailwindcss.com\"></script>"},
+			contains:    []string{"John Doe", "Software Engineer", "<!DOCTYPE html>", "*,::before,::after{box-sizing:border-box"},
 		},
 		{
 			name:        "valid template with CSS",
@@ -376,3 +382,207 @@ func BenchmarkTemplateService_GeneratePreview(b *testing.B) {
 		}
 	}
 }
+
+func TestTemplateService_GeneratePDFWithProgress_Cancelled(t *testing.T) {
+	service := NewTemplateService()
+	resume := models.Resume{Name: "John Doe"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var stages []string
+	_, err := service.GeneratePDFWithProgress(ctx, "<h1>{{.Name}}</h1>", "", resume, time.Second, func(stage string, step int, total int) {
+		if total != PDFStageCount {
+			t.Errorf("Expected total %d, got %d", PDFStageCount, total)
+		}
+		stages = append(stages, stage)
+	})
+	if err == nil {
+		t.Fatal("Expected error for cancelled context")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled error, got %v", err)
+	}
+	if len(stages) != 1 || stages[0] != PDFStageTemplateRendered {
+		t.Errorf("Expected only the template stage to be reported, got %v", stages)
+	}
+}
+
+func TestTemplateService_GeneratePDFWithProgress_TemplateError(t *testing.T) {
+	service := NewTemplateService()
+
+	called := false
+	_, err := service.GeneratePDFWithProgress(context.Background(), "{{.Invalid", "", models.Resume{}, time.Second, func(string, int, int) {
+		called = true
+	})
+	if err == nil || !strings.Contains(err.Error(), "Template parse error") {
+		t.Errorf("Expected template parse error, got %v", err)
+	}
+	if called {
+		t.Error("Expected no progress for a template that fails to render")
+	}
+}
+
+func TestTemplateService_GeneratePageImages_Errors(t *testing.T) {
+	service := NewTemplateService()
+
+	_, err := service.GeneratePageImages(context.Background(), "{{.Invalid", "", models.Resume{}, time.Second)
+	if err == nil || !strings.Contains(err.Error(), "Template parse error") {
+		t.Errorf("Expected template parse error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = service.GeneratePageImages(ctx, "<h1>{{.Name}}</h1>", "", models.Resume{Name: "John Doe"}, time.Second)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled error, got %v", err)
+	}
+}
+
+func TestPlaceOnPage(t *testing.T) {
+	content := image.NewRGBA(image.Rect(0, 0, pageWidthPx-2*pageMarginPx, 100))
+	draw.Draw(content, content.Bounds(), image.Black, image.Point{}, draw.Src)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, content); err != nil {
+		t.Fatalf("Failed to encode content: %v", err)
+	}
+
+	data, err := placeOnPage(buf.Bytes())
+	if err != nil {
+		t.Fatalf("placeOnPage() error = %v", err)
+	}
+	page, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to decode page: %v", err)
+	}
+	if page.Bounds() != image.Rect(0, 0, pageWidthPx, pageHeightPx) {
+		t.Fatalf("Expected a %dx%d page, got %v", pageWidthPx, pageHeightPx, page.Bounds())
+	}
+
+	isBlack := func(x, y int) bool {
+		r, g, b, _ := page.At(x, y).RGBA()
+		return r == 0 && g == 0 && b == 0
+	}
+	if isBlack(pageMarginPx-1, pageMarginPx-1) {
+		t.Error("Expected the margin to stay white")
+	}
+	if !isBlack(pageMarginPx, pageMarginPx) || !isBlack(pageWidthPx-pageMarginPx-1, pageMarginPx+99) {
+		t.Error("Expected the content inside the margins")
+	}
+	if isBlack(pageMarginPx, pageMarginPx+100) {
+		t.Error("Expected the rest of the page to stay white")
+	}
+
+	if _, err := placeOnPage([]byte("not a png")); err == nil {
+		t.Error("Expected error for invalid PNG content")
+	}
+}
+
+func TestTemplateService_GeneratePreviewWithOptions_LiveReload(t *testing.T) {
+	service := NewTemplateService()
+	resume := models.Resume{Name: "John Doe"}
+
+	html, err := service.GeneratePreviewWithOptions("<h1>{{.Name}}</h1>", "", resume, true, "/resume/download/abc", "/resume/preview/abc/events")
+	if err != nil {
+		t.Fatalf("GeneratePreviewWithOptions() error = %v", err)
+	}
+	if !strings.Contains(html, "new EventSource('/resume/preview/abc/events')") {
+		t.Error("Expected preview to subscribe to the events URL")
+	}
+
+	html, err = service.GeneratePreviewWithOptions("<h1>{{.Name}}</h1>", "", resume, false, "", "/resume/preview/abc/events")
+	if err != nil {
+		t.Fatalf("GeneratePreviewWithOptions() error = %v", err)
+	}
+	if strings.Contains(html, "EventSource") {
+		t.Error("Expected no live reload without the app bar")
+	}
+}
+
+func TestTemplateService_GeneratePreview_InlinesStyles(t *testing.T) {
+	service := NewTemplateService()
+	resume := models.Resume{Name: "John Doe"}
+
+	html, err := service.GeneratePreview(`<h1 class="text-3xl font-bold md:text-4xl">{{.Name}}</h1>`, "h1 { color: red; }", resume)
+	if err != nil {
+		t.Fatalf("GeneratePreview() error = %v", err)
+	}
+	for _, rule := range []string{".text-3xl{", ".font-bold{", `.md\:text-4xl{`} {
+		if !strings.Contains(html, rule) {
+			t.Errorf("Expected the page to contain the rule %q", rule)
+		}
+	}
+	if strings.Contains(html, "<script src=") || strings.Contains(html, "<link") {
+		t.Error("Expected the page to load no external resources")
+	}
+	if strings.Index(html, ".text-3xl{") > strings.Index(html, "h1 { color: red; }") {
+		t.Error("Expected the custom CSS after the utilities, so it overrides them")
+	}
+}
+
+func TestTemplateService_TimelineFunction(t *testing.T) {
+	service := NewTemplateService()
+	endDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	resume := models.Resume{
+		WorkExperiences: []models.WorkExperience{
+			{Company: "Tech Corp", StartDate: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), EndDate: &endDate},
+		},
+	}
+
+	html, err := service.GeneratePreview(`{{ with timeline . }}<p>{{ .TotalYears }} years</p>{{ end }}`, "", resume)
+	if err != nil {
+		t.Fatalf("GeneratePreview() error = %v", err)
+	}
+	if !strings.Contains(html, "<p>3 years</p>") {
+		t.Errorf("Expected computed total years in output, got %s", html)
+	}
+}
+
+func TestTemplateService_DateFunctions(t *testing.T) {
+	service := NewTemplateService()
+	endDate := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	resume := models.Resume{
+		WorkExperiences: []models.WorkExperience{
+			{
+				Company:            "Tech Corp",
+				StartDate:          time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC),
+				StartDatePrecision: models.DatePrecisionMonth,
+				EndDate:            &endDate,
+				EndDatePrecision:   models.DatePrecisionYear,
+			},
+			{
+				Company:   "Startup",
+				StartDate: time.Date(2021, 7, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"date range", `{{range .WorkExperiences}}<p>{{dateRange . "Jan 2, 2006"}}</p>{{end}}`, "<p>Mar 2019 - 2021</p><p>Jul 15, 2021 - Present</p>"},
+		{"format partial date", `{{with index .WorkExperiences 0}}{{formatDate .Start "2006-01-02"}}{{end}}`, "Mar 2019"},
+		{"format month layout", `{{with index .WorkExperiences 0}}{{formatDate .Start "01/2006"}}{{end}}`, "03/2019"},
+		{"format plain date", `{{with index .WorkExperiences 1}}{{formatDate .StartDate "2006-01-02"}}{{end}}`, "2021-07-15"},
+		{"format missing end date", `{{with index .WorkExperiences 1}}[{{formatDate .EndDate "2006"}}][{{formatDate .End "2006"}}]{{end}}`, "[][]"},
+		{"current", `{{range .WorkExperiences}}{{.Current}} {{end}}`, "false true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, err := service.GeneratePreview(tt.template, "", resume)
+			if err != nil {
+				t.Fatalf("GeneratePreview() error = %v", err)
+			}
+			if !strings.Contains(html, tt.expected) {
+				t.Errorf("Expected %q in output, got %s", tt.expected, html)
+			}
+		})
+	}
+
+	if _, err := service.GeneratePreview(`{{formatDate .Name "2006"}}`, "", resume); err == nil {
+		t.Error("Expected error formatting a value that isn't a date")
+	}
+}
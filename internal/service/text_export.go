@@ -0,0 +1,130 @@
+package service
+
+import (
+	"embed"
+	"fmt"
+	"regexp"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/rxtech-lab/resume-mcp/internal/markdown"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// Text formats resumes and cover letters can be exported to. They are rendered with text/template,
+// so values are printed as they are instead of being escaped for HTML.
+const (
+	FormatMarkdown = "markdown"
+	FormatText     = "text"
+)
+
+// TextFormat describes a text export format
+type TextFormat struct {
+	Name        string
+	Extension   string
+	ContentType string
+}
+
+var textFormats = []TextFormat{
+	{Name: FormatMarkdown, Extension: ".md", ContentType: "text/markdown; charset=utf-8"},
+	{Name: FormatText, Extension: ".txt", ContentType: "text/plain; charset=utf-8"},
+}
+
+// TextFormatNames returns the names of the text export formats
+func TextFormatNames() []string {
+	names := make([]string, len(textFormats))
+	for i, format := range textFormats {
+		names[i] = format.Name
+	}
+	return names
+}
+
+// LookupTextFormat returns the text export format with the name
+func LookupTextFormat(name string) (TextFormat, error) {
+	for _, format := range textFormats {
+		if format.Name == name {
+			return format, nil
+		}
+	}
+	return TextFormat{}, fmt.Errorf("unsupported format %q, must be one of: %s", name, strings.Join(TextFormatNames(), ", "))
+}
+
+// ExportFileName is the file name offered when downloading the data in the format
+func ExportFileName(data any, format TextFormat) string {
+	return strings.TrimSuffix(PDFFileName(data), ".pdf") + format.Extension
+}
+
+// layouts holds the built-in layout of every text format for resumes and cover letters,
+// named <resume|cover_letter>.<extension>.tmpl
+//
+//go:embed layouts
+var layouts embed.FS
+
+// blankLines matches the runs of empty lines left by the sections of a layout that have no data
+var blankLines = regexp.MustCompile(`\n{3,}`)
+
+// ExportText renders data, a models.Resume or a models.CoverLetter, with the built-in layout of the format
+func (s *TemplateService) ExportText(format string, data any) (string, error) {
+	textFormat, err := LookupTextFormat(format)
+	if err != nil {
+		return "", err
+	}
+
+	kind := models.TemplateTypeResume
+	if _, ok := data.(models.CoverLetter); ok {
+		kind = models.TemplateTypeCoverLetter
+	}
+	layout, err := layouts.ReadFile("layouts/" + kind + textFormat.Extension + ".tmpl")
+	if err != nil {
+		return "", fmt.Errorf("no %s layout for %s: %w", format, kind, err)
+	}
+
+	text, err := s.GenerateText(string(layout), format, data)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(blankLines.ReplaceAllString(text, "\n\n")) + "\n", nil
+}
+
+// GenerateText renders a text template in the format, e.g. a Markdown template. Templates have the
+// functions of HTML templates, except that markdown returns Markdown as it is for the markdown format
+// and as plain text for the others.
+func (s *TemplateService) GenerateText(templateStr, format string, data any) (string, error) {
+	if _, err := LookupTextFormat(format); err != nil {
+		return "", err
+	}
+
+	funcs := texttemplate.FuncMap(templateFuncs())
+	if format == FormatMarkdown {
+		funcs["markdown"] = func(text string) string { return text }
+	} else {
+		funcs["markdown"] = markdown.ToText
+	}
+
+	tmpl, err := s.parseText(templateStr, funcs)
+	if err != nil {
+		return "", fmt.Errorf("Template parse error: %v", err)
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("Template execution error: %v", err)
+	}
+	return out.String(), nil
+}
+
+// parseText parses a text template together with the partials it includes, like parse does for HTML templates
+func (s *TemplateService) parseText(templateStr string, funcs texttemplate.FuncMap) (*texttemplate.Template, error) {
+	used, err := resolvePartials(templateStr, s.partials)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := texttemplate.New(rootTemplateName).Funcs(funcs)
+	for _, name := range used {
+		if _, err := tmpl.New(name).Parse(s.partials[name]); err != nil {
+			return nil, fmt.Errorf("partial %q: %w", name, err)
+		}
+	}
+	return tmpl.Parse(templateStr)
+}
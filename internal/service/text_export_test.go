@@ -0,0 +1,154 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func exportTestResume() models.Resume {
+	endDate := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC)
+	return models.Resume{
+		Name:        "Jane <Doe>",
+		Description: "Backend engineer & **Go** enthusiast",
+		Contacts:    []models.Contact{{Key: "email", Value: "jane@example.com"}, {Key: "phone", Value: "+1 555 0100"}},
+		WorkExperiences: []models.WorkExperience{
+			{
+				Company: "Bank Corp", JobTitle: "Engineer", Type: "fulltime",
+				StartDate: time.Date(2016, 2, 1, 0, 0, 0, 0, time.UTC), StartDatePrecision: models.DatePrecisionMonth,
+				EndDate: &endDate, EndDatePrecision: models.DatePrecisionMonth,
+				FeatureMaps: []models.FeatureMap{{Value: "Payments"}},
+			},
+			{
+				Company: "Fintech Inc", JobTitle: "Staff Engineer", Type: "fulltime",
+				StartDate: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), StartDatePrecision: models.DatePrecisionMonth,
+				FeatureMaps: []models.FeatureMap{{Key: "Impact", Value: "Cut latency:\n- checkout by **40%**\n- search by 20%"}},
+			},
+		},
+		OtherExperiences: []models.OtherExperience{
+			{Category: "Skills", FeatureMaps: []models.FeatureMap{{Key: "Languages", Value: "Go, SQL"}}},
+		},
+	}
+}
+
+func TestExportText_Markdown(t *testing.T) {
+	text, err := NewTemplateService().ExportText(FormatMarkdown, exportTestResume())
+	if err != nil {
+		t.Fatalf("ExportText() error = %v", err)
+	}
+
+	expected := `# Jane <Doe>
+
+jane@example.com · +1 555 0100
+
+Backend engineer & **Go** enthusiast
+
+## Experience
+
+### Staff Engineer, Fintech Inc
+
+*Jul 2021 - Present*
+
+- **Impact:** Cut latency:
+  - checkout by **40%**
+  - search by 20%
+
+### Engineer, Bank Corp
+
+*Feb 2016 - Jun 2021*
+
+- Payments
+
+## Skills
+
+- **Languages:** Go, SQL
+`
+	if text != expected {
+		t.Errorf("Unexpected Markdown:\n%s\nexpected:\n%s", text, expected)
+	}
+}
+
+func TestExportText_PlainText(t *testing.T) {
+	text, err := NewTemplateService().ExportText(FormatText, exportTestResume())
+	if err != nil {
+		t.Fatalf("ExportText() error = %v", err)
+	}
+
+	for _, expected := range []string{
+		"JANE <DOE>\njane@example.com | +1 555 0100\n",
+		"SUMMARY\nBackend engineer & Go enthusiast\n",
+		"EXPERIENCE\n\nStaff Engineer, Fintech Inc\nJul 2021 - Present\n- Impact: Cut latency:\n  - checkout by 40%\n",
+		"SKILLS\n- Languages: Go, SQL\n",
+	} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("Expected plain text to contain %q, got:\n%s", expected, text)
+		}
+	}
+	if strings.ContainsAny(text, "*#") || strings.Contains(text, "\n\n\n") {
+		t.Errorf("Expected plain text without formatting or runs of blank lines, got:\n%s", text)
+	}
+}
+
+func TestExportText_CoverLetter(t *testing.T) {
+	letter := models.CoverLetter{
+		Company: "Example Inc.",
+		Date:    time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Resume:  exportTestResume(),
+		Sections: []models.CoverLetterSection{
+			{Body: "I am writing to apply."},
+			{Heading: "Why me", Body: "Ten years of **payments**."},
+		},
+	}
+
+	text, err := NewTemplateService().ExportText(FormatText, letter)
+	if err != nil {
+		t.Fatalf("ExportText() error = %v", err)
+	}
+	for _, expected := range []string{"March 1, 2024\n\nExample Inc.\n\nDear Hiring Manager,", "WHY ME\nTen years of payments.", "Sincerely,\n\nJane <Doe>\n"} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("Expected cover letter to contain %q, got:\n%s", expected, text)
+		}
+	}
+}
+
+func TestGenerateText(t *testing.T) {
+	service := NewTemplateService().WithPartials(Partials{"heading": "# {{.Name}}"})
+	template := `{{template "heading" .}}
+{{markdown .Description}}`
+
+	text, err := service.GenerateText(template, FormatMarkdown, exportTestResume())
+	if err != nil {
+		t.Fatalf("GenerateText() error = %v", err)
+	}
+	if text != "# Jane <Doe>\nBackend engineer & **Go** enthusiast" {
+		t.Errorf("Expected unescaped Markdown, got %q", text)
+	}
+
+	text, err = service.GenerateText(template, FormatText, exportTestResume())
+	if err != nil {
+		t.Fatalf("GenerateText() error = %v", err)
+	}
+	if !strings.HasSuffix(text, "\nBackend engineer & Go enthusiast") {
+		t.Errorf("Expected markdown to print plain text, got %q", text)
+	}
+
+	if _, err := service.GenerateText(template, "docx", exportTestResume()); err == nil {
+		t.Error("Expected error for an unsupported format")
+	}
+	if _, err := service.GenerateText("{{.Missing}}", FormatText, exportTestResume()); err == nil {
+		t.Error("Expected error for a missing field")
+	}
+}
+
+func TestExportFileName(t *testing.T) {
+	markdown, _ := LookupTextFormat(FormatMarkdown)
+	text, _ := LookupTextFormat(FormatText)
+	if name := ExportFileName(models.Resume{}, markdown); name != "resume.md" {
+		t.Errorf("Expected resume.md, got %s", name)
+	}
+	if name := ExportFileName(models.CoverLetter{}, text); name != "cover-letter.txt" {
+		t.Errorf("Expected cover-letter.txt, got %s", name)
+	}
+}
@@ -0,0 +1,475 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template/parse"
+	"time"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/tailwind"
+)
+
+// Kinds of template validation issues
+const (
+	IssueParse   = "parse"
+	IssueExecute = "execute"
+	IssueField   = "field"
+)
+
+// ValidationSample is data a template is rendered with during validation
+type ValidationSample struct {
+	Name string
+	Data any
+}
+
+// TemplateIssue is a problem found while validating a template. Template is the name of the
+// template or partial the problem is in, and Line and Column locate it there when known.
+type TemplateIssue struct {
+	Kind     string   `json:"kind"`
+	Template string   `json:"template,omitempty"`
+	Line     int      `json:"line,omitempty"`
+	Column   int      `json:"column,omitempty"`
+	Message  string   `json:"message"`
+	Samples  []string `json:"samples,omitempty"`
+}
+
+// TemplateValidation is the result of validating a template
+type TemplateValidation struct {
+	Valid   bool            `json:"valid"`
+	Samples []string        `json:"samples"`
+	Issues  []TemplateIssue `json:"issues"`
+	// UnsupportedClasses are warnings about classes of the rendered samples that get no generated
+	// Tailwind styles. They don't make the template invalid, its own CSS may style them.
+	UnsupportedClasses []string `json:"unsupported_classes,omitempty"`
+}
+
+// SampleResume returns a resume with every section filled and year precision dates. It is the
+// "full" edge-case resume and is used where a template is checked against a single resume.
+func SampleResume() models.Resume {
+	endDate := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC)
+	yearEnd := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return models.Resume{
+		Name:        "Jane Doe",
+		Photo:       "https://example.com/jane.png",
+		Description: "Backend engineer building payment systems.",
+		Contacts: []models.Contact{
+			{Key: "email", Value: "jane@example.com", Category: "contact"},
+			{Key: "website", Value: "https://example.com", Category: "link"},
+		},
+		WorkExperiences: []models.WorkExperience{
+			{
+				Company: "Bank Corp", JobTitle: "Software Engineer", Type: "fulltime",
+				StartDate: time.Date(2016, 2, 15, 0, 0, 0, 0, time.UTC), EndDate: &endDate,
+				FeatureMaps: []models.FeatureMap{{Key: "Impact", Value: "Cut checkout latency by 40%", Category: "achievement"}},
+			},
+		},
+		Educations: []models.Education{
+			{
+				SchoolName: "State University", Category: "BSc Computer Science", Type: "fulltime",
+				StartDate: time.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC), StartDatePrecision: models.DatePrecisionYear,
+				EndDate: &yearEnd, EndDatePrecision: models.DatePrecisionYear,
+				FeatureMaps: []models.FeatureMap{{Key: "GPA", Value: "3.9"}},
+			},
+		},
+		OtherExperiences: []models.OtherExperience{
+			{Category: "Skills", FeatureMaps: []models.FeatureMap{{Key: "Languages", Value: "Go, SQL", Category: "skill"}}},
+		},
+	}
+}
+
+// EdgeCaseResumes returns generated resumes that exercise the branches of a template:
+// empty sections, current jobs, partial dates, long text and Unicode
+func EdgeCaseResumes() []ValidationSample {
+	endDate := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	longText := strings.Repeat("Designed, built and operated distributed systems handling millions of requests per day. ", 30)
+	var manyFeatures []models.FeatureMap
+	for i := 1; i <= 25; i++ {
+		manyFeatures = append(manyFeatures, models.FeatureMap{Key: fmt.Sprintf("Achievement %d", i), Value: longText[:200], Category: "achievement"})
+	}
+
+	return []ValidationSample{
+		{Name: "empty sections", Data: models.Resume{Name: "Empty Resume"}},
+		{Name: "full", Data: SampleResume()},
+		{Name: "current jobs", Data: models.Resume{
+			Name: "Current Worker",
+			WorkExperiences: []models.WorkExperience{
+				{Company: "Startup", JobTitle: "Lead", Type: "fulltime", StartDate: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), StartDatePrecision: models.DatePrecisionMonth},
+				{Company: "Side Project", JobTitle: "Founder", Type: "parttime", StartDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), StartDatePrecision: models.DatePrecisionYear},
+			},
+			Educations: []models.Education{
+				{SchoolName: "Open University", Type: "parttime", StartDate: time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		}},
+		{Name: "long text", Data: models.Resume{
+			Name:        strings.Repeat("Maximilian-Alexander ", 5),
+			Description: longText,
+			WorkExperiences: []models.WorkExperience{
+				{
+					Company: strings.Repeat("International Holdings ", 6), JobTitle: strings.Repeat("Principal Engineer ", 6),
+					StartDate: time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), EndDate: &endDate,
+					FeatureMaps: manyFeatures,
+				},
+			},
+			OtherExperiences: []models.OtherExperience{{Category: strings.Repeat("Publications ", 8), FeatureMaps: manyFeatures}},
+		}},
+		{Name: "unicode", Data: models.Resume{
+			Name:        "Zoë <O'Brien> & Søn 山田太郎",
+			Description: `Uses "quotes", <tags>, emoji 🚀 and right-to-left text: مهندس برمجيات`,
+			Contacts:    []models.Contact{{Key: "电子邮件", Value: "zoë@example.com"}},
+			WorkExperiences: []models.WorkExperience{
+				{Company: "Ärzte & Söhne GmbH", JobTitle: "Ingénieur", StartDate: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), EndDate: &endDate},
+			},
+			Educations: []models.Education{
+				{SchoolName: "東京大学", Category: "Master's", StartDate: time.Date(2015, 4, 1, 0, 0, 0, 0, time.UTC), EndDate: &endDate},
+			},
+		}},
+	}
+}
+
+// ValidateTemplate renders the template with every sample and checks the fields it references
+// against the type of the sample data. Failures are reported once with the samples they occur for.
+func (s *TemplateService) ValidateTemplate(templateStr string, samples []ValidationSample) TemplateValidation {
+	return s.ValidateTemplateFormat(templateStr, models.TemplateFormatHTML, samples)
+}
+
+// ValidateTemplateFormat validates a template of the format like ValidateTemplate, LaTeX templates
+// are rendered to LaTeX source
+func (s *TemplateService) ValidateTemplateFormat(templateStr, format string, samples []ValidationSample) TemplateValidation {
+	validation := TemplateValidation{Valid: true, Samples: []string{}, Issues: []TemplateIssue{}}
+	for _, sample := range samples {
+		validation.Samples = append(validation.Samples, sample.Name)
+	}
+
+	trees, err := s.parseTrees(templateStr, format)
+	if err != nil {
+		validation.Valid = false
+		validation.Issues = append(validation.Issues, newTemplateIssue(IssueParse, err))
+		return validation
+	}
+
+	if len(samples) > 0 {
+		checker := &fieldChecker{templates: trees, checked: map[string]bool{}}
+		checker.check(rootTemplateName, reflect.TypeOf(samples[0].Data))
+		validation.Issues = append(validation.Issues, checker.issues...)
+	}
+
+	// A render failing on a field already reported as missing adds nothing to the field issue
+	fieldLocations := map[string]bool{}
+	for _, issue := range validation.Issues {
+		fieldLocations[issue.location()] = true
+	}
+
+	seen := map[string]int{}
+	for _, sample := range samples {
+		output, err := s.RenderTemplate(templateStr, format, sample.Data)
+		if err != nil {
+			issue := newTemplateIssue(IssueExecute, err)
+			if fieldLocations[issue.location()] {
+				continue
+			}
+			if i, ok := seen[issue.Message]; ok {
+				validation.Issues[i].Samples = append(validation.Issues[i].Samples, sample.Name)
+				continue
+			}
+			issue.Samples = []string{sample.Name}
+			seen[issue.Message] = len(validation.Issues)
+			validation.Issues = append(validation.Issues, issue)
+			continue
+		}
+
+		if format == models.TemplateFormatLaTeX {
+			continue
+		}
+		for _, class := range tailwind.Unsupported(output) {
+			if !slices.Contains(validation.UnsupportedClasses, class) {
+				validation.UnsupportedClasses = append(validation.UnsupportedClasses, class)
+			}
+		}
+	}
+
+	validation.Valid = len(validation.Issues) == 0
+	return validation
+}
+
+// parseTrees parses the template in its format and returns the parse trees of it and its partials by name
+func (s *TemplateService) parseTrees(templateStr, format string) (map[string]*parse.Tree, error) {
+	trees := map[string]*parse.Tree{}
+	if format == models.TemplateFormatLaTeX {
+		tmpl, err := s.parseLaTeX(templateStr)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tmpl.Templates() {
+			if t.Tree != nil {
+				trees[t.Name()] = t.Tree
+			}
+		}
+		return trees, nil
+	}
+
+	tmpl, err := s.parse(templateStr)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			trees[t.Name()] = t.Tree
+		}
+	}
+	return trees, nil
+}
+
+// templateErrorLocation matches the location Go templates put in their errors, e.g. "template: resume:3:12: "
+var templateErrorLocation = regexp.MustCompile(`(?:template: |html/template:)([^:\s]+):(\d+)(?::(\d+))?: `)
+
+// executingPrefix starts execution errors, e.g. `executing "resume" at <.Name>: ...`
+var executingPrefix = regexp.MustCompile(`^executing "[^"]*" `)
+
+// nodeLocation matches the location of a template node, e.g. "resume:3:12"
+var nodeLocation = regexp.MustCompile(`^([^:]+):(\d+):(\d+)$`)
+
+// location returns the position of the issue as template:line:column
+func (i TemplateIssue) location() string {
+	return fmt.Sprintf("%s:%d:%d", i.Template, i.Line, i.Column)
+}
+
+// newTemplateIssue turns a parse or execution error into an issue with the location of the error
+func newTemplateIssue(kind string, err error) TemplateIssue {
+	message := err.Error()
+	for _, prefix := range []string{"Template parse error: ", "Template execution error: "} {
+		message = strings.TrimPrefix(message, prefix)
+	}
+
+	issue := TemplateIssue{Kind: kind, Message: message}
+	if match := templateErrorLocation.FindStringSubmatchIndex(message); match != nil {
+		issue.Template = message[match[2]:match[3]]
+		issue.Line, _ = strconv.Atoi(message[match[4]:match[5]])
+		if match[6] >= 0 {
+			issue.Column, _ = strconv.Atoi(message[match[6]:match[7]])
+		}
+		issue.Message = message[:match[0]] + message[match[1]:]
+	}
+	issue.Message = executingPrefix.ReplaceAllString(issue.Message, "")
+	return issue
+}
+
+// fieldChecker statically follows the type of dot through a template and reports field references
+// that don't exist on it. Values whose type can't be known, e.g. results of most functions, aren't checked.
+type fieldChecker struct {
+	templates map[string]*parse.Tree
+	checked   map[string]bool
+	issues    []TemplateIssue
+	tree      *parse.Tree
+}
+
+// listFunctions return a list of the same type as their last argument
+var listFunctions = map[string]bool{"sortByDate": true, "limit": true}
+
+func (c *fieldChecker) check(name string, dot reflect.Type) {
+	tree := c.templates[name]
+	key := fmt.Sprintf("%s/%v", name, dot)
+	if tree == nil || tree.Root == nil || c.checked[key] {
+		return
+	}
+	c.checked[key] = true
+
+	previous := c.tree
+	c.tree = tree
+	c.list(tree.Root, dot, map[string]reflect.Type{"$": dot})
+	c.tree = previous
+}
+
+func (c *fieldChecker) list(list *parse.ListNode, dot reflect.Type, vars map[string]reflect.Type) {
+	if list == nil {
+		return
+	}
+	for _, node := range list.Nodes {
+		switch n := node.(type) {
+		case *parse.ActionNode:
+			c.pipe(n.Pipe, dot, vars)
+		case *parse.IfNode:
+			c.pipe(n.Pipe, dot, vars)
+			c.list(n.List, dot, scope(vars))
+			c.list(n.ElseList, dot, scope(vars))
+		case *parse.WithNode:
+			inner := scope(vars)
+			c.list(n.List, c.pipe(n.Pipe, dot, inner), inner)
+			c.list(n.ElseList, dot, scope(vars))
+		case *parse.RangeNode:
+			inner := scope(vars)
+			element := elementType(c.pipe(n.Pipe, dot, inner))
+			if len(n.Pipe.Decl) == 2 {
+				inner[n.Pipe.Decl[0].Ident[0]] = nil
+				inner[n.Pipe.Decl[1].Ident[0]] = element
+			} else if len(n.Pipe.Decl) == 1 {
+				inner[n.Pipe.Decl[0].Ident[0]] = element
+			}
+			c.list(n.List, element, inner)
+			c.list(n.ElseList, dot, scope(vars))
+		case *parse.TemplateNode:
+			// Templates called without data have no dot to check
+			if n.Pipe != nil {
+				if argument := c.pipe(n.Pipe, dot, vars); argument != nil {
+					c.check(n.Name, argument)
+				}
+			}
+		}
+	}
+}
+
+// pipe checks the pipeline and returns the type of its value, nil when unknown
+func (c *fieldChecker) pipe(pipe *parse.PipeNode, dot reflect.Type, vars map[string]reflect.Type) reflect.Type {
+	if pipe == nil {
+		return nil
+	}
+	var result reflect.Type
+	for i, cmd := range pipe.Cmds {
+		result = c.command(cmd, dot, vars, result, i > 0)
+	}
+	for _, variable := range pipe.Decl {
+		vars[variable.Ident[0]] = result
+	}
+	return result
+}
+
+// command checks the arguments of the command and returns the type of its value. piped is the
+// type of the previous command's value in the pipeline, passed as the last argument of functions.
+func (c *fieldChecker) command(cmd *parse.CommandNode, dot reflect.Type, vars map[string]reflect.Type, piped reflect.Type, isPiped bool) reflect.Type {
+	types := make([]reflect.Type, len(cmd.Args))
+	for i, arg := range cmd.Args {
+		types[i] = c.arg(arg, dot, vars)
+	}
+
+	function, ok := cmd.Args[0].(*parse.IdentifierNode)
+	if !ok {
+		return types[0]
+	}
+	last := piped
+	if !isPiped && len(types) > 1 {
+		last = types[len(types)-1]
+	}
+	switch {
+	case listFunctions[function.Ident]:
+		return last
+	case function.Ident == "index" && len(types) > 1:
+		return elementType(types[1])
+	}
+	return nil
+}
+
+// arg checks an argument and returns its type, nil when unknown
+func (c *fieldChecker) arg(node parse.Node, dot reflect.Type, vars map[string]reflect.Type) reflect.Type {
+	switch n := node.(type) {
+	case *parse.DotNode:
+		return dot
+	case *parse.FieldNode:
+		return c.fields(n, dot, n.Ident)
+	case *parse.VariableNode:
+		return c.fields(n, vars[n.Ident[0]], n.Ident[1:])
+	case *parse.ChainNode:
+		var base reflect.Type
+		if pipe, ok := n.Node.(*parse.PipeNode); ok {
+			base = c.pipe(pipe, dot, vars)
+		} else {
+			base = c.arg(n.Node, dot, vars)
+		}
+		return c.fields(n, base, n.Field)
+	case *parse.PipeNode:
+		return c.pipe(n, dot, vars)
+	}
+	return nil
+}
+
+// fields resolves a chain of field or method names on the type, reporting the first one that doesn't exist
+func (c *fieldChecker) fields(node parse.Node, typ reflect.Type, names []string) reflect.Type {
+	for _, name := range names {
+		if typ == nil {
+			return nil
+		}
+		next, ok := fieldType(typ, name)
+		if !ok {
+			location, _ := c.tree.ErrorContext(node)
+			issue := TemplateIssue{
+				Kind:    IssueField,
+				Message: fmt.Sprintf("%s has no field or method %s", typeName(typ), name),
+			}
+			if match := nodeLocation.FindStringSubmatch(location); match != nil {
+				issue.Template = match[1]
+				issue.Line, _ = strconv.Atoi(match[2])
+				issue.Column, _ = strconv.Atoi(match[3])
+			}
+			c.issues = append(c.issues, issue)
+			return nil
+		}
+		typ = next
+	}
+	return typ
+}
+
+// fieldType returns the type of the field, method result or map value with the name, and whether it exists.
+// Types whose fields can't be known statically, like interfaces, report every name as existing.
+func fieldType(typ reflect.Type, name string) (reflect.Type, bool) {
+	if method, ok := reflect.PointerTo(derefType(typ)).MethodByName(name); ok {
+		if method.Type.NumOut() == 0 {
+			return nil, true
+		}
+		return method.Type.Out(0), true
+	}
+	typ = derefType(typ)
+	switch typ.Kind() {
+	case reflect.Struct:
+		field, ok := typ.FieldByName(name)
+		if !ok || !field.IsExported() {
+			return nil, false
+		}
+		return field.Type, true
+	case reflect.Map:
+		return typ.Elem(), true
+	case reflect.Interface:
+		return nil, true
+	}
+	return nil, false
+}
+
+// elementType returns the type of the items of a list, nil when unknown
+func elementType(typ reflect.Type) reflect.Type {
+	if typ == nil {
+		return nil
+	}
+	typ = derefType(typ)
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if typ.Elem().Kind() == reflect.Interface {
+			return nil
+		}
+		return typ.Elem()
+	}
+	return nil
+}
+
+func derefType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// typeName names the type for issues, e.g. models.WorkExperience
+func typeName(typ reflect.Type) string {
+	return derefType(typ).String()
+}
+
+func scope(vars map[string]reflect.Type) map[string]reflect.Type {
+	inner := make(map[string]reflect.Type, len(vars))
+	for name, typ := range vars {
+		inner[name] = typ
+	}
+	return inner
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func TestValidateTemplate_Valid(t *testing.T) {
+	template := `<h1>{{.Name}}</h1>
+{{range .WorkExperiences | sortByDate "desc"}}
+<h2>{{.JobTitle}} at {{.Company}}</h2>
+<p>{{dateRange . "Jan 2006"}}{{if not .Current}} ({{.EndDate.Format "2006"}}){{end}}</p>
+{{range $feature := .FeatureMaps}}<li>{{$feature.Key}}: {{$.Name}}</li>{{end}}
+{{end}}
+{{with index .Contacts 0}}{{.Value}}{{end}}`
+
+	validation := NewTemplateService().ValidateTemplate(strings.Replace(template, "{{with index .Contacts 0}}{{.Value}}{{end}}", "", 1), EdgeCaseResumes())
+	if !validation.Valid {
+		t.Fatalf("Expected template to be valid, got %+v", validation.Issues)
+	}
+	if len(validation.Samples) != len(EdgeCaseResumes()) {
+		t.Errorf("Expected every sample to be listed, got %v", validation.Samples)
+	}
+}
+
+func TestValidateTemplate_UnsupportedClasses(t *testing.T) {
+	template := `<h1 class="text-2xl name-heading">{{.Name}}</h1>
+{{range .WorkExperiences}}<p class="{{if .Current}}dark:text-white{{else}}text-gray-600{{end}}">{{.Company}}</p>{{end}}`
+
+	validation := NewTemplateService().ValidateTemplate(template, EdgeCaseResumes())
+	if !validation.Valid {
+		t.Fatalf("Expected unsupported classes not to make the template invalid, got %+v", validation.Issues)
+	}
+	// Classes inside branches are found in the samples that render them
+	if strings.Join(validation.UnsupportedClasses, " ") != "name-heading dark:text-white" {
+		t.Errorf("Expected the unsupported classes as warnings, got %v", validation.UnsupportedClasses)
+	}
+}
+
+func TestValidateTemplate_RenderFailures(t *testing.T) {
+	// Dereferencing the end date works until a sample has a current job, and the first contact
+	// only exists in some samples
+	template := `<h1>{{.Name}}</h1>
+{{range .WorkExperiences}}
+<p>{{.EndDate.Format "2006"}}</p>
+{{end}}`
+
+	validation := NewTemplateService().ValidateTemplate(template, EdgeCaseResumes())
+	if validation.Valid || len(validation.Issues) != 1 {
+		t.Fatalf("Expected one issue, got %+v", validation.Issues)
+	}
+	issue := validation.Issues[0]
+	if issue.Kind != IssueExecute || issue.Template != "resume" || issue.Line != 3 {
+		t.Errorf("Expected execution issue on line 3, got %+v", issue)
+	}
+	if len(issue.Samples) != 1 || issue.Samples[0] != "current jobs" {
+		t.Errorf("Expected the failure to be reported for the current jobs sample, got %v", issue.Samples)
+	}
+	if strings.Contains(issue.Message, "template: resume") {
+		t.Errorf("Expected the location to be removed from the message, got %q", issue.Message)
+	}
+}
+
+func TestValidateTemplate_MissingFields(t *testing.T) {
+	template := `<h1>{{.FullName}}</h1>
+{{range .WorkExperiences}}{{if .Title}}<h2>{{.Title}}</h2>{{end}}{{.Company}}{{end}}
+{{range $e := .Educations}}{{$e.School}}{{end}}
+{{with .OtherExperiences}}{{range .}}{{.Category.Name}}{{end}}{{end}}
+{{$.Missing}}`
+
+	validation := NewTemplateService().ValidateTemplate(template, EdgeCaseResumes())
+	expected := []struct {
+		line    int
+		message string
+	}{
+		{1, "models.Resume has no field or method FullName"},
+		{2, "models.WorkExperience has no field or method Title"},
+		{2, "models.WorkExperience has no field or method Title"},
+		{3, "models.Education has no field or method School"},
+		{4, "string has no field or method Name"},
+		{5, "models.Resume has no field or method Missing"},
+	}
+
+	var fields []TemplateIssue
+	for _, issue := range validation.Issues {
+		if issue.Kind == IssueField {
+			fields = append(fields, issue)
+		}
+	}
+	if len(fields) != len(expected) {
+		t.Fatalf("Expected %d field issues, got %+v", len(expected), fields)
+	}
+	for i, e := range expected {
+		if fields[i].Line != e.line || fields[i].Message != e.message || fields[i].Column == 0 {
+			t.Errorf("Issue %d: expected %q on line %d, got %+v", i, e.message, e.line, fields[i])
+		}
+	}
+}
+
+func TestValidateTemplate_FieldsThroughPartialsAndFunctions(t *testing.T) {
+	service := NewTemplateService().WithPartials(Partials{
+		"job": "<h2>{{.JobTitle}}</h2>\n{{.Salary}}",
+	})
+	template := `{{range .WorkExperiences | sortByDate "desc" | limit 2}}{{template "job" .}}{{end}}
+{{with index .Educations 0}}{{.Degree}}{{end}}
+{{.Start}}`
+
+	validation := service.ValidateTemplate(template, EdgeCaseResumes())
+	var messages []string
+	for _, issue := range validation.Issues {
+		if issue.Kind == IssueField {
+			messages = append(messages, issue.Template+":"+issue.Message)
+		}
+	}
+	expected := []string{
+		"job:models.WorkExperience has no field or method Salary",
+		"resume:models.Education has no field or method Degree",
+		"resume:models.Resume has no field or method Start",
+	}
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected field issues:\n%s\nexpected:\n%s", strings.Join(messages, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestValidateTemplate_ParseError(t *testing.T) {
+	validation := NewTemplateService().ValidateTemplate("<h1>{{.Name}}</h1>\n{{if .Name}}", EdgeCaseResumes())
+	if validation.Valid || len(validation.Issues) != 1 {
+		t.Fatalf("Expected one issue, got %+v", validation.Issues)
+	}
+	if issue := validation.Issues[0]; issue.Kind != IssueParse || issue.Line != 2 {
+		t.Errorf("Expected parse issue on line 2, got %+v", issue)
+	}
+}
+
+func TestValidateTemplate_CoverLetter(t *testing.T) {
+	samples := []ValidationSample{{Name: "letter", Data: models.CoverLetter{Recipient: "Alex"}}}
+	validation := NewTemplateService().ValidateTemplate(`{{.Recipient}} {{.Resume.Name}} {{.Resume.Title}}`, samples)
+	if len(validation.Issues) != 1 || validation.Issues[0].Message != "models.Resume has no field or method Title" {
+		t.Errorf("Expected the missing resume field, got %+v", validation.Issues)
+	}
+}
@@ -0,0 +1,277 @@
+// Package tailwind generates the CSS of the Tailwind CSS utility classes a page uses, so rendered
+// templates are styled without loading Tailwind from its CDN and PDFs render without network access.
+//
+// The generator covers the utilities resumes are laid out with: spacing, sizing, flexbox and grid,
+// typography, the default color palette with opacity modifiers, gradients, borders, shadows, rings and print breaks.
+// Classes may use the responsive (sm: to 2xl:), print: and state (hover:, focus:, first:, ...)
+// variants, the ! prefix for !important, negative margins and arbitrary values like w-[12.5rem].
+package tailwind
+
+import (
+	_ "embed"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// preflight is Tailwind's base stylesheet, which resets the browser defaults the utilities build on
+//
+//go:embed preflight.css
+var preflight string
+
+// Stylesheet returns the base styles followed by the rules of every supported utility class used in the html
+func Stylesheet(html string) string {
+	css, _ := Generate(Classes(html))
+	return preflight + css
+}
+
+// Unsupported returns the classes of the html that aren't supported utilities and get no generated styles,
+// e.g. classes styled by the page's own CSS or Tailwind features the generator doesn't cover
+func Unsupported(html string) []string {
+	_, unsupported := Generate(Classes(html))
+	return unsupported
+}
+
+var classAttribute = regexp.MustCompile(`(?i)\sclass\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+
+// Classes returns the distinct class names of the class attributes in the html, in order of appearance
+func Classes(html string) []string {
+	var classes []string
+	seen := map[string]bool{}
+	for _, match := range classAttribute.FindAllStringSubmatch(html, -1) {
+		for _, class := range strings.Fields(match[1] + " " + match[2]) {
+			if !seen[class] {
+				seen[class] = true
+				classes = append(classes, class)
+			}
+		}
+	}
+	return classes
+}
+
+// rule is the CSS generated for one class
+type rule struct {
+	class       string
+	media       string
+	mediaRank   int
+	variantRank int
+	order       int
+	css         string
+}
+
+// Generate returns the CSS rules of the classes and the classes that aren't supported utilities
+func Generate(classes []string) (string, []string) {
+	var rules []rule
+	var unsupported []string
+	keyframes := map[string]bool{}
+	seen := map[string]bool{}
+
+	for _, class := range classes {
+		if seen[class] {
+			continue
+		}
+		seen[class] = true
+
+		r, frames, ok := generate(class)
+		if !ok {
+			unsupported = append(unsupported, class)
+			continue
+		}
+		rules = append(rules, r)
+		if frames != "" {
+			keyframes[frames] = true
+		}
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		a, b := rules[i], rules[j]
+		if a.mediaRank != b.mediaRank {
+			return a.mediaRank < b.mediaRank
+		}
+		if a.variantRank != b.variantRank {
+			return a.variantRank < b.variantRank
+		}
+		if a.order != b.order {
+			return a.order < b.order
+		}
+		return a.class < b.class
+	})
+
+	var out strings.Builder
+	frames := make([]string, 0, len(keyframes))
+	for f := range keyframes {
+		frames = append(frames, f)
+	}
+	sort.Strings(frames)
+	for _, f := range frames {
+		out.WriteString(f + "\n")
+	}
+
+	for i := 0; i < len(rules); {
+		media := rules[i].media
+		if media != "" {
+			out.WriteString("@media " + media + "{\n")
+		}
+		for ; i < len(rules) && rules[i].media == media; i++ {
+			out.WriteString(rules[i].css + "\n")
+		}
+		if media != "" {
+			out.WriteString("}\n")
+		}
+	}
+	return out.String(), unsupported
+}
+
+// generate returns the rule of the class and the keyframes it needs
+func generate(class string) (rule, string, bool) {
+	parts := splitVariants(class)
+	name := parts[len(parts)-1]
+
+	r := rule{class: class}
+	var media []string
+	var pseudo, parent string
+	for _, variant := range parts[:len(parts)-1] {
+		if i := indexOf(breakpointNames, variant); i >= 0 {
+			media = append(media, "(min-width:"+breakpoints[variant]+")")
+			r.mediaRank += i + 1
+			continue
+		}
+		if variant == "print" {
+			media = append([]string{"print"}, media...)
+			r.mediaRank += len(breakpointNames) + 1
+			continue
+		}
+		if variant == "group-hover" {
+			parent = ".group:hover "
+			r.variantRank += len(pseudoVariantNames) + 1
+			continue
+		}
+		i := indexOf(pseudoVariantNames, variant)
+		if i < 0 {
+			return rule{}, "", false
+		}
+		pseudo += pseudoVariants[variant]
+		r.variantRank += i + 1
+	}
+	r.media = strings.Join(media, " and ")
+
+	important := strings.HasPrefix(name, "!")
+	name = strings.TrimPrefix(name, "!")
+	negative := strings.HasPrefix(name, "-")
+	name = strings.TrimPrefix(name, "-")
+
+	u, declarations, ok := resolve(name, negative)
+	if !ok {
+		return rule{}, "", false
+	}
+	if important {
+		declarations = strings.ReplaceAll(declarations, ";", "!important;") + "!important"
+	}
+
+	r.order = u.order
+	r.css = parent + "." + escapeClass(class) + pseudo + u.child + "{" + declarations + "}"
+	return r, u.keyframes, true
+}
+
+// resolve finds the utility of the class name without variants. Longer roots are tried first,
+// so border-t-2 resolves to border-t with value 2 before border with value t-2.
+func resolve(name string, negative bool) (*utility, string, bool) {
+	end := len(name)
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		end = i
+	}
+	candidates := []int{len(name)}
+	for i := end - 1; i > 0; i-- {
+		if name[i] == '-' {
+			candidates = append(candidates, i)
+		}
+	}
+
+	for _, i := range candidates {
+		root, value := name[:i], ""
+		if i < len(name) {
+			value = name[i+1:]
+		}
+		for _, index := range utilityIndex[root] {
+			u := &utilities[index]
+			if negative && !u.negative {
+				continue
+			}
+			if declarations, ok := u.css(value, negative); ok {
+				return u, declarations, true
+			}
+		}
+	}
+	return nil, "", false
+}
+
+// splitVariants splits the class at the colons that aren't part of an arbitrary value
+func splitVariants(class string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range class {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ':':
+			if depth == 0 {
+				parts = append(parts, class[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, class[start:])
+}
+
+// escapeClass escapes the class name for use in a CSS selector
+func escapeClass(class string) string {
+	var out strings.Builder
+	for i, c := range class {
+		switch {
+		case i == 0 && c >= '0' && c <= '9':
+			out.WriteString(`\3` + string(c) + " ")
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_', c > 127:
+			out.WriteRune(c)
+		default:
+			out.WriteString(`\` + string(c))
+		}
+	}
+	return out.String()
+}
+
+var breakpointNames = []string{"sm", "md", "lg", "xl", "2xl"}
+
+var breakpoints = map[string]string{
+	"sm":  "640px",
+	"md":  "768px",
+	"lg":  "1024px",
+	"xl":  "1280px",
+	"2xl": "1536px",
+}
+
+var pseudoVariantNames = []string{"first", "last", "odd", "even", "visited", "focus-within", "hover", "focus", "focus-visible", "active", "disabled"}
+
+var pseudoVariants = map[string]string{
+	"first":         ":first-child",
+	"last":          ":last-child",
+	"odd":           ":nth-child(odd)",
+	"even":          ":nth-child(even)",
+	"visited":       ":visited",
+	"focus-within":  ":focus-within",
+	"hover":         ":hover",
+	"focus":         ":focus",
+	"focus-visible": ":focus-visible",
+	"active":        ":active",
+	"disabled":      ":disabled",
+}
+
+func indexOf(list []string, value string) int {
+	for i, v := range list {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,118 @@
+package tailwind
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClasses(t *testing.T) {
+	html := `<div class="mt-4  text-gray-600"><p class='mt-4 md:flex'>{{x}}</p><span data-class="ignored">x</span></div>`
+	got := strings.Join(Classes(html), " ")
+	if got != "mt-4 text-gray-600 md:flex" {
+		t.Errorf("Classes() = %q", got)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		class string
+		rule  string
+	}{
+		{"mt-4", ".mt-4{margin-top:1rem}"},
+		{"px-2.5", `.px-2\.5{padding-left:0.625rem;padding-right:0.625rem}`},
+		{"-mt-2", ".-mt-2{margin-top:-0.5rem}"},
+		{"mx-auto", ".mx-auto{margin-left:auto;margin-right:auto}"},
+		{"w-1/3", `.w-1\/3{width:33.333333%}`},
+		{"max-w-3xl", ".max-w-3xl{max-width:48rem}"},
+		{"min-h-screen", ".min-h-screen{min-height:100vh}"},
+		{"w-[12.5rem]", `.w-\[12\.5rem\]{width:12.5rem}`},
+		{"text-sm", ".text-sm{font-size:0.875rem;line-height:1.25rem}"},
+		{"text-center", ".text-center{text-align:center}"},
+		{"text-gray-600", ".text-gray-600{color:#4b5563}"},
+		{"text-[13px]", `.text-\[13px\]{font-size:13px}`},
+		{"text-[#123456]", `.text-\[\#123456\]{color:#123456}`},
+		{"bg-black/50", `.bg-black\/50{background-color:rgb(0 0 0 / 0.5)}`},
+		{"font-semibold", ".font-semibold{font-weight:600}"},
+		{"border", ".border{border-width:1px}"},
+		{"border-b-2", ".border-b-2{border-bottom-width:2px}"},
+		{"border-gray-300", ".border-gray-300{border-color:#d1d5db}"},
+		{"rounded-full", ".rounded-full{border-radius:9999px}"},
+		{"space-y-2", ".space-y-2 > :not([hidden]) ~ :not([hidden]){margin-top:0.5rem;margin-bottom:0}"},
+		{"grid-cols-3", ".grid-cols-3{grid-template-columns:repeat(3,minmax(0,1fr))}"},
+		{"break-inside-avoid", ".break-inside-avoid{break-inside:avoid}"},
+		{"!hidden", `.\!hidden{display:none!important}`},
+		{"hover:underline", `.hover\:underline:hover{text-decoration-line:underline}`},
+		{"group-hover:text-white", `.group:hover .group-hover\:text-white{color:#fff}`},
+		{"2xl:flex", `.\32 xl\:flex{display:flex}`},
+		{"bg-gradient-to-r", ".bg-gradient-to-r{background-image:linear-gradient(to right,var(--tw-gradient-stops))}"},
+		{"from-blue-500", ".from-blue-500{--tw-gradient-from:#3b82f6;--tw-gradient-to:rgb(59 130 246 / 0);--tw-gradient-stops:var(--tw-gradient-from),var(--tw-gradient-to)}"},
+		{"via-white", ".via-white{--tw-gradient-to:rgb(255 255 255 / 0);--tw-gradient-stops:var(--tw-gradient-from),#fff,var(--tw-gradient-to)}"},
+		{"to-transparent", ".to-transparent{--tw-gradient-to:transparent}"},
+		{"ring-2", ".ring-2{box-shadow:var(--tw-ring-inset,) 0 0 0 var(--tw-ring-offset-width,0px) var(--tw-ring-offset-color,#fff),var(--tw-ring-inset,) 0 0 0 calc(2px + var(--tw-ring-offset-width,0px)) var(--tw-ring-color,rgb(59 130 246 / 0.5))}"},
+		{"ring-blue-500/50", `.ring-blue-500\/50{--tw-ring-color:rgb(59 130 246 / 0.5)}`},
+		{"ring-inset", ".ring-inset{--tw-ring-inset:inset}"},
+		{"ring-offset-2", ".ring-offset-2{--tw-ring-offset-width:2px}"},
+		{"ring-offset-gray-100", ".ring-offset-gray-100{--tw-ring-offset-color:#f3f4f6}"},
+	}
+
+	for _, test := range tests {
+		css, unsupported := Generate([]string{test.class})
+		if len(unsupported) > 0 {
+			t.Errorf("%s: unsupported", test.class)
+			continue
+		}
+		if !strings.Contains(css, test.rule) {
+			t.Errorf("%s: expected %q in\n%s", test.class, test.rule, css)
+		}
+	}
+}
+
+func TestGenerate_Unsupported(t *testing.T) {
+	_, unsupported := Generate([]string{"resume-header", "dark:bg-black", "text-gray-650", "w-[x;y]", "mt-4"})
+	if strings.Join(unsupported, " ") != "resume-header dark:bg-black text-gray-650 w-[x;y]" {
+		t.Errorf("Unexpected unsupported classes: %v", unsupported)
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	html := `<div class="resume-header bg-gradient-to-r from-blue-500 to-indigo-500"><p class="ring-2 dark:text-white">x</p></div>`
+	if got := strings.Join(Unsupported(html), " "); got != "resume-header dark:text-white" {
+		t.Errorf("Unsupported() = %q", got)
+	}
+}
+
+func TestGenerate_Order(t *testing.T) {
+	// Responsive and print rules come last so they override the base rules, and specific sides override
+	// shorthands regardless of the order of the classes
+	css, _ := Generate([]string{"print:hidden", "md:p-8", "px-2", "p-4", "hover:p-1", "md:hover:p-2"})
+
+	order := []string{".p-4{", ".px-2{", `.hover\:p-1:hover{`, "@media (min-width:768px){", `.md\:p-8{`, `.md\:hover\:p-2:hover{`, "@media print{", `.print\:hidden{`}
+	last := -1
+	for _, part := range order {
+		i := strings.Index(css, part)
+		if i <= last {
+			t.Fatalf("Expected %q after the previous rules in\n%s", part, css)
+		}
+		last = i
+	}
+}
+
+func TestGenerate_Keyframes(t *testing.T) {
+	css, _ := Generate([]string{"animate-spin", "md:animate-spin"})
+	if strings.Count(css, "@keyframes spin") != 1 {
+		t.Errorf("Expected the keyframes once, got\n%s", css)
+	}
+}
+
+func TestStylesheet(t *testing.T) {
+	css := Stylesheet(`<ul class="list-disc ml-6"><li>One</li></ul>`)
+	if !strings.HasPrefix(css, "*,::before,::after{") {
+		t.Errorf("Expected the stylesheet to start with the base styles")
+	}
+	if !strings.Contains(css, ".list-disc{list-style-type:disc}") || !strings.Contains(css, ".ml-6{margin-left:1.5rem}") {
+		t.Errorf("Expected the used utilities in\n%s", css)
+	}
+	if strings.Contains(css, ".mt-4") {
+		t.Error("Expected only the used utilities")
+	}
+}
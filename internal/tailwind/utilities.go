@@ -0,0 +1,671 @@
+package tailwind
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// utility generates the declarations of the classes starting with root
+type utility struct {
+	// root is the class name without its value, e.g. "mt" for mt-4
+	root string
+	// negative allows the -root-value form
+	negative bool
+	// css returns the declarations for the value, which is empty for classes without one
+	css func(value string, negative bool) (string, bool)
+	// child is appended to the selector, e.g. to space the children of space-y
+	child string
+	// keyframes are emitted once when a class of the utility is used
+	keyframes string
+	// order is the position of the utility, later utilities override earlier ones
+	order int
+}
+
+// utilities are in the order of Tailwind's core plugins, so the generated rules override each other like Tailwind's
+var utilities []utility
+
+// utilityIndex maps roots to the positions of their utilities
+var utilityIndex = map[string][]int{}
+
+func init() {
+	add := func(u utility) {
+		u.order = len(utilities)
+		utilityIndex[u.root] = append(utilityIndex[u.root], len(utilities))
+		utilities = append(utilities, u)
+	}
+	statics := func(classes ...string) {
+		for i := 0; i < len(classes); i += 2 {
+			add(static(classes[i], classes[i+1]))
+		}
+	}
+
+	statics("sr-only", "position:absolute;width:1px;height:1px;padding:0;margin:-1px;overflow:hidden;clip:rect(0,0,0,0);white-space:nowrap;border-width:0",
+		"visible", "visibility:visible", "invisible", "visibility:hidden", "pointer-events-none", "pointer-events:none", "pointer-events-auto", "pointer-events:auto",
+		"static", "position:static", "fixed", "position:fixed", "absolute", "position:absolute", "relative", "position:relative", "sticky", "position:sticky")
+
+	add(scaled("inset", []string{"inset"}, insetValue))
+	add(scaled("inset-x", []string{"left", "right"}, insetValue))
+	add(scaled("inset-y", []string{"top", "bottom"}, insetValue))
+	for _, side := range []string{"top", "right", "bottom", "left"} {
+		add(scaled(side, []string{side}, insetValue))
+	}
+
+	add(keyword("z", "z-index", map[string]string{"0": "0", "10": "10", "20": "20", "30": "30", "40": "40", "50": "50", "auto": "auto"}))
+	add(utility{root: "order", negative: true, css: func(value string, negative bool) (string, bool) {
+		v, ok := map[string]string{"first": "-9999", "last": "9999", "none": "0"}[value]
+		if !ok {
+			if n, err := strconv.Atoi(value); err == nil && n >= 1 && n <= 12 {
+				v = value
+			} else if v, ok = arbitrary(value); !ok {
+				return "", false
+			}
+		}
+		if negative {
+			v = negate(v)
+		}
+		return "order:" + v, true
+	}})
+
+	add(span("col-span", "grid-column"))
+	add(numbered("col-start", "grid-column-start", 13))
+	add(numbered("col-end", "grid-column-end", 13))
+	add(span("row-span", "grid-row"))
+	add(numbered("row-start", "grid-row-start", 13))
+	add(numbered("row-end", "grid-row-end", 13))
+
+	statics("float-right", "float:right", "float-left", "float:left", "float-none", "float:none",
+		"clear-left", "clear:left", "clear-right", "clear:right", "clear-both", "clear:both", "clear-none", "clear:none")
+
+	add(scaled("m", []string{"margin"}, marginValue))
+	add(scaled("mx", []string{"margin-left", "margin-right"}, marginValue))
+	add(scaled("my", []string{"margin-top", "margin-bottom"}, marginValue))
+	add(scaled("ms", []string{"margin-inline-start"}, marginValue))
+	add(scaled("me", []string{"margin-inline-end"}, marginValue))
+	add(scaled("mt", []string{"margin-top"}, marginValue))
+	add(scaled("mr", []string{"margin-right"}, marginValue))
+	add(scaled("mb", []string{"margin-bottom"}, marginValue))
+	add(scaled("ml", []string{"margin-left"}, marginValue))
+
+	statics("box-border", "box-sizing:border-box", "box-content", "box-sizing:content-box",
+		"block", "display:block", "inline-block", "display:inline-block", "inline", "display:inline",
+		"flex", "display:flex", "inline-flex", "display:inline-flex", "table", "display:table",
+		"table-row", "display:table-row", "table-cell", "display:table-cell", "grid", "display:grid",
+		"inline-grid", "display:inline-grid", "contents", "display:contents", "list-item", "display:list-item",
+		"hidden", "display:none",
+		"aspect-auto", "aspect-ratio:auto", "aspect-square", "aspect-ratio:1 / 1", "aspect-video", "aspect-ratio:16 / 9")
+
+	add(scaled("size", []string{"width", "height"}, sizeValue(nil)))
+	add(scaled("h", []string{"height"}, sizeValue(map[string]string{"screen": "100vh"})))
+	add(scaled("max-h", []string{"max-height"}, sizeValue(map[string]string{"none": "none", "screen": "100vh"})))
+	add(scaled("min-h", []string{"min-height"}, sizeValue(map[string]string{"screen": "100vh"})))
+	add(scaled("w", []string{"width"}, sizeValue(map[string]string{"screen": "100vw"})))
+	add(scaled("min-w", []string{"min-width"}, sizeValue(nil)))
+	add(scaled("max-w", []string{"max-width"}, sizeValue(map[string]string{
+		"none": "none", "xs": "20rem", "sm": "24rem", "md": "28rem", "lg": "32rem", "xl": "36rem",
+		"2xl": "42rem", "3xl": "48rem", "4xl": "56rem", "5xl": "64rem", "6xl": "72rem", "7xl": "80rem",
+		"prose": "65ch", "screen-sm": "640px", "screen-md": "768px", "screen-lg": "1024px", "screen-xl": "1280px", "screen-2xl": "1536px",
+	})))
+
+	statics("flex-1", "flex:1 1 0%", "flex-auto", "flex:1 1 auto", "flex-initial", "flex:0 1 auto", "flex-none", "flex:none",
+		"shrink", "flex-shrink:1", "shrink-0", "flex-shrink:0", "flex-shrink", "flex-shrink:1", "flex-shrink-0", "flex-shrink:0",
+		"grow", "flex-grow:1", "grow-0", "flex-grow:0", "flex-grow", "flex-grow:1", "flex-grow-0", "flex-grow:0")
+	add(scaled("basis", []string{"flex-basis"}, sizeValue(nil)))
+
+	statics("table-auto", "table-layout:auto", "table-fixed", "table-layout:fixed",
+		"border-collapse", "border-collapse:collapse", "border-separate", "border-collapse:separate")
+
+	add(utility{root: "animate-spin", css: exact("animation:spin 1s linear infinite"), keyframes: "@keyframes spin{to{transform:rotate(360deg)}}"})
+	add(utility{root: "animate-pulse", css: exact("animation:pulse 2s cubic-bezier(0.4,0,0.6,1) infinite"), keyframes: "@keyframes pulse{50%{opacity:.5}}"})
+	statics("animate-none", "animation:none",
+		"cursor-auto", "cursor:auto", "cursor-default", "cursor:default", "cursor-pointer", "cursor:pointer", "cursor-not-allowed", "cursor:not-allowed",
+		"select-none", "user-select:none", "select-text", "user-select:text", "select-all", "user-select:all",
+		"list-inside", "list-style-position:inside", "list-outside", "list-style-position:outside",
+		"list-disc", "list-style-type:disc", "list-decimal", "list-style-type:decimal", "list-none", "list-style-type:none",
+		"appearance-none", "appearance:none")
+
+	for _, property := range []string{"break-before", "break-after"} {
+		statics(property+"-auto", property+":auto", property+"-avoid", property+":avoid", property+"-all", property+":all",
+			property+"-avoid-page", property+":avoid-page", property+"-page", property+":page",
+			property+"-left", property+":left", property+"-right", property+":right", property+"-column", property+":column")
+		if property == "break-before" {
+			statics("break-inside-auto", "break-inside:auto", "break-inside-avoid", "break-inside:avoid",
+				"break-inside-avoid-page", "break-inside:avoid-page", "break-inside-avoid-column", "break-inside:avoid-column")
+		}
+	}
+
+	add(utility{root: "grid-cols", css: gridTemplate("grid-template-columns")})
+	add(utility{root: "grid-rows", css: gridTemplate("grid-template-rows")})
+
+	statics("flex-row", "flex-direction:row", "flex-row-reverse", "flex-direction:row-reverse",
+		"flex-col", "flex-direction:column", "flex-col-reverse", "flex-direction:column-reverse",
+		"flex-wrap", "flex-wrap:wrap", "flex-wrap-reverse", "flex-wrap:wrap-reverse", "flex-nowrap", "flex-wrap:nowrap")
+
+	add(named("content", "align-content", alignments))
+	add(named("items", "align-items", map[string]string{"start": "flex-start", "end": "flex-end", "center": "center", "baseline": "baseline", "stretch": "stretch"}))
+	add(named("justify", "justify-content", alignments))
+	add(named("justify-items", "justify-items", map[string]string{"start": "start", "end": "end", "center": "center", "stretch": "stretch"}))
+	add(named("place-items", "place-items", map[string]string{"start": "start", "end": "end", "center": "center", "baseline": "baseline", "stretch": "stretch"}))
+
+	add(scaled("gap", []string{"gap"}, spacingValue))
+	add(scaled("gap-x", []string{"column-gap"}, spacingValue))
+	add(scaled("gap-y", []string{"row-gap"}, spacingValue))
+
+	const between = " > :not([hidden]) ~ :not([hidden])"
+	add(utility{root: "space-x", negative: true, child: between, css: func(value string, negative bool) (string, bool) {
+		v, ok := marginValue(value, negative)
+		return "margin-right:0;margin-left:" + v, ok && value != ""
+	}})
+	add(utility{root: "space-y", negative: true, child: between, css: func(value string, negative bool) (string, bool) {
+		v, ok := marginValue(value, negative)
+		return "margin-top:" + v + ";margin-bottom:0", ok && value != ""
+	}})
+	add(utility{root: "divide-x", child: between, css: func(value string, _ bool) (string, bool) {
+		v, ok := borderWidth(value)
+		return "border-right-width:0;border-left-width:" + v, ok
+	}})
+	add(utility{root: "divide-y", child: between, css: func(value string, _ bool) (string, bool) {
+		v, ok := borderWidth(value)
+		return "border-top-width:" + v + ";border-bottom-width:0", ok
+	}})
+	add(colored("divide", "border-color", between))
+	add(utility{root: "divide-solid", child: between, css: exact("border-style:solid")})
+	add(utility{root: "divide-dashed", child: between, css: exact("border-style:dashed")})
+
+	add(named("self", "align-self", map[string]string{"auto": "auto", "start": "flex-start", "end": "flex-end", "center": "center", "stretch": "stretch", "baseline": "baseline"}))
+
+	for _, axis := range []string{"", "-x", "-y"} {
+		for _, value := range []string{"auto", "hidden", "clip", "visible", "scroll"} {
+			add(static("overflow"+axis+"-"+value, "overflow"+axis+":"+value))
+		}
+	}
+
+	statics("truncate", "overflow:hidden;text-overflow:ellipsis;white-space:nowrap", "text-ellipsis", "text-overflow:ellipsis", "text-clip", "text-overflow:clip")
+	add(named("whitespace", "white-space", map[string]string{"normal": "normal", "nowrap": "nowrap", "pre": "pre", "pre-line": "pre-line", "pre-wrap": "pre-wrap", "break-spaces": "break-spaces"}))
+	statics("break-normal", "overflow-wrap:normal;word-break:normal", "break-words", "overflow-wrap:break-word",
+		"break-all", "word-break:break-all", "break-keep", "word-break:keep-all",
+		"hyphens-auto", "hyphens:auto", "hyphens-none", "hyphens:none", "hyphens-manual", "hyphens:manual")
+
+	add(rounded("rounded", "border-radius"))
+	add(rounded("rounded-s", "border-start-start-radius", "border-end-start-radius"))
+	add(rounded("rounded-e", "border-start-end-radius", "border-end-end-radius"))
+	add(rounded("rounded-t", "border-top-left-radius", "border-top-right-radius"))
+	add(rounded("rounded-r", "border-top-right-radius", "border-bottom-right-radius"))
+	add(rounded("rounded-b", "border-bottom-right-radius", "border-bottom-left-radius"))
+	add(rounded("rounded-l", "border-top-left-radius", "border-bottom-left-radius"))
+	add(rounded("rounded-tl", "border-top-left-radius"))
+	add(rounded("rounded-tr", "border-top-right-radius"))
+	add(rounded("rounded-br", "border-bottom-right-radius"))
+	add(rounded("rounded-bl", "border-bottom-left-radius"))
+
+	borderSides := map[string][]string{
+		"border": {"border"}, "border-x": {"border-left", "border-right"}, "border-y": {"border-top", "border-bottom"},
+		"border-s": {"border-inline-start"}, "border-e": {"border-inline-end"},
+		"border-t": {"border-top"}, "border-r": {"border-right"}, "border-b": {"border-bottom"}, "border-l": {"border-left"},
+	}
+	borderRoots := []string{"border", "border-x", "border-y", "border-s", "border-e", "border-t", "border-r", "border-b", "border-l"}
+	for _, root := range borderRoots {
+		properties := borderSides[root]
+		add(utility{root: root, css: func(value string, _ bool) (string, bool) {
+			v, ok := borderWidth(value)
+			if !ok {
+				return "", false
+			}
+			return declare(suffixed(properties, "-width"), v), true
+		}})
+	}
+	for _, style := range []string{"solid", "dashed", "dotted", "double", "hidden", "none"} {
+		add(static("border-"+style, "border-style:"+style))
+	}
+	for _, root := range borderRoots {
+		add(colored(root, strings.Join(suffixed(borderSides[root], "-color"), ","), ""))
+	}
+
+	add(colored("bg", "background-color", ""))
+	add(named("bg-gradient-to", "background-image", map[string]string{
+		"t": "linear-gradient(to top,var(--tw-gradient-stops))", "tr": "linear-gradient(to top right,var(--tw-gradient-stops))",
+		"r": "linear-gradient(to right,var(--tw-gradient-stops))", "br": "linear-gradient(to bottom right,var(--tw-gradient-stops))",
+		"b": "linear-gradient(to bottom,var(--tw-gradient-stops))", "bl": "linear-gradient(to bottom left,var(--tw-gradient-stops))",
+		"l": "linear-gradient(to left,var(--tw-gradient-stops))", "tl": "linear-gradient(to top left,var(--tw-gradient-stops))",
+	}))
+	statics("bg-none", "background-image:none")
+	add(gradientStop("from", func(c string) string {
+		return "--tw-gradient-from:" + c + ";--tw-gradient-to:" + transparent(c) + ";--tw-gradient-stops:var(--tw-gradient-from),var(--tw-gradient-to)"
+	}))
+	add(gradientStop("via", func(c string) string {
+		return "--tw-gradient-to:" + transparent(c) + ";--tw-gradient-stops:var(--tw-gradient-from)," + c + ",var(--tw-gradient-to)"
+	}))
+	add(gradientStop("to", func(c string) string {
+		return "--tw-gradient-to:" + c
+	}))
+
+	statics("object-contain", "object-fit:contain", "object-cover", "object-fit:cover", "object-fill", "object-fit:fill",
+		"object-none", "object-fit:none", "object-scale-down", "object-fit:scale-down",
+		"object-center", "object-position:center", "object-top", "object-position:top", "object-bottom", "object-position:bottom",
+		"object-left", "object-position:left", "object-right", "object-position:right")
+
+	add(scaled("p", []string{"padding"}, spacingValue))
+	add(scaled("px", []string{"padding-left", "padding-right"}, spacingValue))
+	add(scaled("py", []string{"padding-top", "padding-bottom"}, spacingValue))
+	add(scaled("ps", []string{"padding-inline-start"}, spacingValue))
+	add(scaled("pe", []string{"padding-inline-end"}, spacingValue))
+	add(scaled("pt", []string{"padding-top"}, spacingValue))
+	add(scaled("pr", []string{"padding-right"}, spacingValue))
+	add(scaled("pb", []string{"padding-bottom"}, spacingValue))
+	add(scaled("pl", []string{"padding-left"}, spacingValue))
+
+	add(named("text", "text-align", map[string]string{"left": "left", "center": "center", "right": "right", "justify": "justify", "start": "start", "end": "end"}))
+	add(keyword("indent", "text-indent", spacing))
+	add(named("align", "vertical-align", map[string]string{"baseline": "baseline", "top": "top", "middle": "middle", "bottom": "bottom", "text-top": "text-top", "text-bottom": "text-bottom", "sub": "sub", "super": "super"}))
+	add(named("font", "font-family", map[string]string{
+		"sans":  `ui-sans-serif,system-ui,sans-serif,"Apple Color Emoji","Segoe UI Emoji","Segoe UI Symbol","Noto Color Emoji"`,
+		"serif": `ui-serif,Georgia,Cambria,"Times New Roman",Times,serif`,
+		"mono":  `ui-monospace,SFMono-Regular,Menlo,Monaco,Consolas,"Liberation Mono","Courier New",monospace`,
+	}))
+	add(utility{root: "text", css: func(value string, _ bool) (string, bool) {
+		if size, ok := fontSizes[value]; ok {
+			return "font-size:" + size[0] + ";line-height:" + size[1], true
+		}
+		if v, ok := arbitrary(value); ok && !isColor(v) {
+			return "font-size:" + v, true
+		}
+		return "", false
+	}})
+	add(named("font", "font-weight", map[string]string{
+		"thin": "100", "extralight": "200", "light": "300", "normal": "400", "medium": "500",
+		"semibold": "600", "bold": "700", "extrabold": "800", "black": "900",
+	}))
+	statics("uppercase", "text-transform:uppercase", "lowercase", "text-transform:lowercase",
+		"capitalize", "text-transform:capitalize", "normal-case", "text-transform:none",
+		"italic", "font-style:italic", "not-italic", "font-style:normal",
+		"tabular-nums", "font-variant-numeric:tabular-nums")
+	add(keyword("leading", "line-height", map[string]string{
+		"3": ".75rem", "4": "1rem", "5": "1.25rem", "6": "1.5rem", "7": "1.75rem", "8": "2rem", "9": "2.25rem", "10": "2.5rem",
+		"none": "1", "tight": "1.25", "snug": "1.375", "normal": "1.5", "relaxed": "1.625", "loose": "2",
+	}))
+	add(keyword("tracking", "letter-spacing", map[string]string{
+		"tighter": "-0.05em", "tight": "-0.025em", "normal": "0em", "wide": "0.025em", "wider": "0.05em", "widest": "0.1em",
+	}))
+	add(colored("text", "color", ""))
+	statics("underline", "text-decoration-line:underline", "overline", "text-decoration-line:overline",
+		"line-through", "text-decoration-line:line-through", "no-underline", "text-decoration-line:none")
+	add(colored("decoration", "text-decoration-color", ""))
+	add(keyword("underline-offset", "text-underline-offset", map[string]string{"auto": "auto", "0": "0px", "1": "1px", "2": "2px", "4": "4px", "8": "8px"}))
+
+	add(utility{root: "opacity", css: func(value string, _ bool) (string, bool) {
+		v, ok := opacity(value)
+		return "opacity:" + v, ok
+	}})
+
+	add(keyword("shadow", "box-shadow", map[string]string{
+		"":      "0 1px 3px 0 rgb(0 0 0 / 0.1),0 1px 2px -1px rgb(0 0 0 / 0.1)",
+		"sm":    "0 1px 2px 0 rgb(0 0 0 / 0.05)",
+		"md":    "0 4px 6px -1px rgb(0 0 0 / 0.1),0 2px 4px -2px rgb(0 0 0 / 0.1)",
+		"lg":    "0 10px 15px -3px rgb(0 0 0 / 0.1),0 4px 6px -4px rgb(0 0 0 / 0.1)",
+		"xl":    "0 20px 25px -5px rgb(0 0 0 / 0.1),0 8px 10px -6px rgb(0 0 0 / 0.1)",
+		"2xl":   "0 25px 50px -12px rgb(0 0 0 / 0.25)",
+		"inner": "inset 0 2px 4px 0 rgb(0 0 0 / 0.05)",
+		"none":  "0 0 #0000",
+	}))
+	statics("outline-none", "outline:2px solid transparent;outline-offset:2px", "outline", "outline-style:solid")
+	// Rings are drawn outside an offset, which is filled with the offset color
+	add(utility{root: "ring", css: func(value string, _ bool) (string, bool) {
+		if value == "" {
+			value = "3"
+		}
+		v, ok := borderWidth(value)
+		return "box-shadow:var(--tw-ring-inset,) 0 0 0 var(--tw-ring-offset-width,0px) var(--tw-ring-offset-color,#fff)," +
+			"var(--tw-ring-inset,) 0 0 0 calc(" + v + " + var(--tw-ring-offset-width,0px)) var(--tw-ring-color,rgb(59 130 246 / 0.5))", ok
+	}})
+	statics("ring-inset", "--tw-ring-inset:inset")
+	add(colored("ring", "--tw-ring-color", ""))
+	add(utility{root: "ring-offset", css: func(value string, _ bool) (string, bool) {
+		if value == "" {
+			return "", false
+		}
+		v, ok := borderWidth(value)
+		return "--tw-ring-offset-width:" + v, ok
+	}})
+	add(colored("ring-offset", "--tw-ring-offset-color", ""))
+
+	statics("transition", "transition-property:color,background-color,border-color,text-decoration-color,fill,stroke,opacity,box-shadow,transform,filter;transition-timing-function:cubic-bezier(0.4,0,0.2,1);transition-duration:150ms",
+		"transition-colors", "transition-property:color,background-color,border-color,text-decoration-color,fill,stroke;transition-timing-function:cubic-bezier(0.4,0,0.2,1);transition-duration:150ms",
+		"transition-opacity", "transition-property:opacity;transition-timing-function:cubic-bezier(0.4,0,0.2,1);transition-duration:150ms",
+		"transition-all", "transition-property:all;transition-timing-function:cubic-bezier(0.4,0,0.2,1);transition-duration:150ms",
+		"transition-none", "transition-property:none")
+}
+
+// static is a utility without a value, e.g. flex
+func static(class, declarations string) utility {
+	return utility{root: class, css: exact(declarations)}
+}
+
+func exact(declarations string) func(string, bool) (string, bool) {
+	return func(value string, _ bool) (string, bool) {
+		return declarations, value == ""
+	}
+}
+
+// scaled sets the properties to the value returned by resolve
+func scaled(root string, properties []string, resolve func(string, bool) (string, bool)) utility {
+	return utility{root: root, negative: true, css: func(value string, negative bool) (string, bool) {
+		if value == "" {
+			return "", false
+		}
+		v, ok := resolve(value, negative)
+		if !ok {
+			return "", false
+		}
+		return declare(properties, v), true
+	}}
+}
+
+// keyword sets the property to one of the named values or an arbitrary value
+func keyword(root, property string, values map[string]string) utility {
+	return utility{root: root, css: func(value string, _ bool) (string, bool) {
+		if v, ok := values[value]; ok {
+			return property + ":" + v, true
+		}
+		if v, ok := arbitrary(value); ok && !isColor(v) {
+			return property + ":" + v, true
+		}
+		return "", false
+	}}
+}
+
+// named sets the property to one of the named values
+func named(root, property string, values map[string]string) utility {
+	return utility{root: root, css: func(value string, _ bool) (string, bool) {
+		v, ok := values[value]
+		return property + ":" + v, ok
+	}}
+}
+
+// colored sets the comma separated properties to a palette color
+func colored(root, properties, child string) utility {
+	return utility{root: root, child: child, css: func(value string, _ bool) (string, bool) {
+		c, ok := color(value)
+		if !ok {
+			return "", false
+		}
+		return declare(strings.Split(properties, ","), c), true
+	}}
+}
+
+// gradientStop sets a color stop of the gradient of bg-gradient-to
+func gradientStop(root string, declarations func(color string) string) utility {
+	return utility{root: root, css: func(value string, _ bool) (string, bool) {
+		c, ok := color(value)
+		if !ok {
+			return "", false
+		}
+		return declarations(c), true
+	}}
+}
+
+func rounded(root string, properties ...string) utility {
+	radii := map[string]string{"": "0.25rem", "none": "0px", "sm": "0.125rem", "md": "0.375rem", "lg": "0.5rem", "xl": "0.75rem", "2xl": "1rem", "3xl": "1.5rem", "full": "9999px"}
+	return utility{root: root, css: func(value string, _ bool) (string, bool) {
+		v, ok := radii[value]
+		if !ok {
+			if v, ok = arbitrary(value); !ok {
+				return "", false
+			}
+		}
+		return declare(properties, v), true
+	}}
+}
+
+func span(root, property string) utility {
+	return utility{root: root, css: func(value string, _ bool) (string, bool) {
+		if value == "full" {
+			return property + ":1 / -1", true
+		}
+		if n, err := strconv.Atoi(value); err == nil && n >= 1 && n <= 12 {
+			return fmt.Sprintf("%s:span %d / span %d", property, n, n), true
+		}
+		return "", false
+	}}
+}
+
+func numbered(root, property string, max int) utility {
+	return utility{root: root, css: func(value string, _ bool) (string, bool) {
+		if value == "auto" {
+			return property + ":auto", true
+		}
+		if n, err := strconv.Atoi(value); err == nil && n >= 1 && n <= max {
+			return property + ":" + value, true
+		}
+		return "", false
+	}}
+}
+
+func gridTemplate(property string) func(string, bool) (string, bool) {
+	return func(value string, _ bool) (string, bool) {
+		if value == "none" {
+			return property + ":none", true
+		}
+		if n, err := strconv.Atoi(value); err == nil && n >= 1 && n <= 12 {
+			return fmt.Sprintf("%s:repeat(%d,minmax(0,1fr))", property, n), true
+		}
+		if v, ok := arbitrary(value); ok {
+			return property + ":" + v, true
+		}
+		return "", false
+	}
+}
+
+func declare(properties []string, value string) string {
+	declarations := make([]string, len(properties))
+	for i, property := range properties {
+		declarations[i] = property + ":" + value
+	}
+	return strings.Join(declarations, ";")
+}
+
+func suffixed(properties []string, suffix string) []string {
+	out := make([]string, len(properties))
+	for i, property := range properties {
+		out[i] = property + suffix
+	}
+	return out
+}
+
+var alignments = map[string]string{
+	"normal": "normal", "start": "flex-start", "end": "flex-end", "center": "center",
+	"between": "space-between", "around": "space-around", "evenly": "space-evenly", "stretch": "stretch",
+}
+
+// spacing is Tailwind's spacing scale, used by padding, margin, gap, sizes and insets
+var spacing = map[string]string{"0": "0px", "px": "1px"}
+
+func init() {
+	for _, step := range []float64{0.5, 1, 1.5, 2, 2.5, 3, 3.5, 4, 5, 6, 7, 8, 9, 10, 11, 12, 14, 16, 20, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 72, 80, 96} {
+		spacing[strconv.FormatFloat(step, 'f', -1, 64)] = strconv.FormatFloat(step/4, 'f', -1, 64) + "rem"
+	}
+}
+
+var fontSizes = map[string][2]string{
+	"xs": {"0.75rem", "1rem"}, "sm": {"0.875rem", "1.25rem"}, "base": {"1rem", "1.5rem"},
+	"lg": {"1.125rem", "1.75rem"}, "xl": {"1.25rem", "1.75rem"}, "2xl": {"1.5rem", "2rem"},
+	"3xl": {"1.875rem", "2.25rem"}, "4xl": {"2.25rem", "2.5rem"}, "5xl": {"3rem", "1"},
+	"6xl": {"3.75rem", "1"}, "7xl": {"4.5rem", "1"}, "8xl": {"6rem", "1"}, "9xl": {"8rem", "1"},
+}
+
+func spacingValue(value string, negative bool) (string, bool) {
+	v, ok := spacing[value]
+	if !ok {
+		if v, ok = arbitrary(value); !ok {
+			return "", false
+		}
+	}
+	if negative {
+		v = negate(v)
+	}
+	return v, true
+}
+
+func marginValue(value string, negative bool) (string, bool) {
+	if value == "auto" && !negative {
+		return "auto", true
+	}
+	return spacingValue(value, negative)
+}
+
+func insetValue(value string, negative bool) (string, bool) {
+	if v, ok := fraction(value); ok {
+		if negative {
+			v = negate(v)
+		}
+		return v, true
+	}
+	if value == "full" {
+		if negative {
+			return "-100%", true
+		}
+		return "100%", true
+	}
+	return marginValue(value, negative)
+}
+
+// sizeValue resolves widths and heights, named values take precedence over the common ones
+func sizeValue(named map[string]string) func(string, bool) (string, bool) {
+	return func(value string, negative bool) (string, bool) {
+		if negative {
+			return "", false
+		}
+		if v, ok := named[value]; ok {
+			return v, true
+		}
+		if v, ok := map[string]string{"auto": "auto", "full": "100%", "min": "min-content", "max": "max-content", "fit": "fit-content"}[value]; ok {
+			return v, true
+		}
+		if v, ok := fraction(value); ok {
+			return v, true
+		}
+		return spacingValue(value, false)
+	}
+}
+
+// fraction resolves values like 1/3 to percentages
+func fraction(value string) (string, bool) {
+	numerator, denominator, found := strings.Cut(value, "/")
+	if !found {
+		return "", false
+	}
+	n, err1 := strconv.Atoi(numerator)
+	d, err2 := strconv.Atoi(denominator)
+	if err1 != nil || err2 != nil || n <= 0 || d <= 0 || n >= d || d > 12 {
+		return "", false
+	}
+	return strconv.FormatFloat(float64(n)*100/float64(d), 'f', 6, 64) + "%", true
+}
+
+func borderWidth(value string) (string, bool) {
+	switch value {
+	case "":
+		return "1px", true
+	case "0", "2", "4", "8":
+		return value + "px", true
+	}
+	if v, ok := arbitrary(value); ok && !isColor(v) {
+		return v, true
+	}
+	return "", false
+}
+
+// color resolves palette colors like gray-600, optionally followed by an opacity modifier like /50
+func color(value string) (string, bool) {
+	name, alpha := value, ""
+	if i := strings.LastIndexByte(value, '/'); i > strings.LastIndexByte(value, ']') {
+		name, alpha = value[:i], value[i+1:]
+	}
+
+	var hex string
+	if named, ok := namedColors[name]; ok {
+		hex = named
+	} else if v, ok := arbitrary(name); ok && isColor(v) {
+		hex = v
+	} else {
+		hue, shade, found := strings.Cut(name, "-")
+		i := indexOf(shades, shade)
+		if !found || i < 0 || palette[hue] == nil {
+			return "", false
+		}
+		hex = palette[hue][i]
+	}
+
+	if alpha == "" {
+		return hex, true
+	}
+	a, ok := opacity(alpha)
+	if !ok {
+		return "", false
+	}
+	r, g, b, ok := rgb(hex)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("rgb(%d %d %d / %s)", r, g, b, a), true
+}
+
+// opacity resolves opacity steps like 50 to 0.5
+func opacity(value string) (string, bool) {
+	if v, ok := arbitrary(value); ok {
+		return v, true
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 || n > 100 {
+		return "", false
+	}
+	return strconv.FormatFloat(float64(n)/100, 'f', -1, 64), true
+}
+
+// transparent returns the color with zero opacity, which gradients fade to so they don't fade through gray
+func transparent(c string) string {
+	if r, g, b, ok := rgb(c); ok {
+		return fmt.Sprintf("rgb(%d %d %d / 0)", r, g, b)
+	}
+	return "transparent"
+}
+
+func rgb(hex string) (int, int, int, bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return 0, 0, 0, false
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return int(n >> 16), int(n >> 8 & 0xff), int(n & 0xff), true
+}
+
+// arbitrary returns the value of arbitrary values like [12.5rem], underscores stand for spaces
+func arbitrary(value string) (string, bool) {
+	if len(value) < 3 || value[0] != '[' || value[len(value)-1] != ']' {
+		return "", false
+	}
+	inner := value[1 : len(value)-1]
+	if strings.ContainsAny(inner, "{};") {
+		return "", false
+	}
+	return strings.ReplaceAll(inner, "_", " "), true
+}
+
+func isColor(value string) bool {
+	return strings.HasPrefix(value, "#") || strings.HasPrefix(value, "rgb") || strings.HasPrefix(value, "hsl")
+}
+
+func negate(value string) string {
+	if value == "0px" || value == "0" {
+		return value
+	}
+	if value[0] >= '0' && value[0] <= '9' || value[0] == '.' {
+		return "-" + value
+	}
+	return "calc(" + value + " * -1)"
+}
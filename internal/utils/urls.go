@@ -37,3 +37,38 @@ func GetDownloadSessionUrl(serverPort string, sessionId string) (string, error)
 	url := fmt.Sprintf("http://localhost:%s/resume/download/%s", serverPort, sessionId)
 	return url, nil
 }
+
+func GetPacketDownloadUrl(serverPort string, packetId string) (string, error) {
+	// Override baseUrl if BASE_URL env var is set
+	if os.Getenv("BASE_URL") != "" {
+		baseUrl := os.Getenv("BASE_URL")
+		parsedUrl, err := url.Parse(baseUrl)
+		if err != nil {
+			return "", fmt.Errorf("invalid BASE_URL env var: %w", err)
+		}
+		parsedUrl.Path = fmt.Sprintf("/resume/packet/%s", packetId)
+		return parsedUrl.String(), nil
+	}
+
+	url := fmt.Sprintf("http://localhost:%s/resume/packet/%s", serverPort, packetId)
+	return url, nil
+}
+
+func GetExportSessionUrl(serverPort string, sessionId string, format string) (string, error) {
+	query := url.Values{"format": {format}}.Encode()
+
+	// Override baseUrl if BASE_URL env var is set
+	if os.Getenv("BASE_URL") != "" {
+		baseUrl := os.Getenv("BASE_URL")
+		parsedUrl, err := url.Parse(baseUrl)
+		if err != nil {
+			return "", fmt.Errorf("invalid BASE_URL env var: %w", err)
+		}
+		parsedUrl.Path = fmt.Sprintf("/resume/export/%s", sessionId)
+		parsedUrl.RawQuery = query
+		return parsedUrl.String(), nil
+	}
+
+	url := fmt.Sprintf("http://localhost:%s/resume/export/%s?%s", serverPort, sessionId, query)
+	return url, nil
+}
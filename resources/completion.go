@@ -0,0 +1,91 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+// maxCompletionValues is the maximum number of values allowed in a completion response
+const maxCompletionValues = 100
+
+// CompletionProvider suggests resume, template and preview session IDs for
+// resource template arguments. Suggestions are scoped to the authenticated
+// user and match the typed value against the ID or a name prefix.
+type CompletionProvider struct {
+	db *database.Database
+}
+
+func NewCompletionProvider(db *database.Database) *CompletionProvider {
+	return &CompletionProvider{db: db}
+}
+
+func (p *CompletionProvider) CompleteResourceArgument(ctx context.Context, uri string, argument mcp.CompleteArgument, completeContext mcp.CompleteContext) (*mcp.Completion, error) {
+	user := types.GetAuthenticatedUser(ctx)
+	userID := &user.Sub
+
+	var values []string
+	switch argument.Name {
+	case "resume_id":
+		resumes, err := p.db.ListResumes(userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resumes: %w", err)
+		}
+		for _, resume := range resumes {
+			if matchesPrefix(argument.Value, resume.ID, resume.Name) {
+				values = append(values, strconv.FormatUint(uint64(resume.ID), 10))
+			}
+		}
+	case "template_id":
+		templates, err := p.db.ListTemplates(userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list templates: %w", err)
+		}
+		// Narrow down to the templates usable with a resume that was already chosen
+		resumeID := completeContext.Arguments["resume_id"]
+		for _, template := range templates {
+			if resumeID != "" && !template.IsUserTemplate() && strconv.FormatUint(uint64(*template.ResumeID), 10) != resumeID {
+				continue
+			}
+			if matchesPrefix(argument.Value, template.ID, template.Name) {
+				values = append(values, strconv.FormatUint(uint64(template.ID), 10))
+			}
+		}
+	case "session_id":
+		sessions, err := p.db.ListPreviewSessions(userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list preview sessions: %w", err)
+		}
+		for _, session := range sessions {
+			if strings.HasPrefix(session.ID, argument.Value) || hasPrefixFold(session.Resume.Name, argument.Value) {
+				values = append(values, session.ID)
+			}
+		}
+	}
+
+	completion := &mcp.Completion{
+		Values: []string{},
+		Total:  len(values),
+	}
+	if len(values) > maxCompletionValues {
+		completion.Values = values[:maxCompletionValues]
+		completion.HasMore = true
+	} else if values != nil {
+		completion.Values = values
+	}
+	return completion, nil
+}
+
+// matchesPrefix matches the typed value against the start of the ID or, case-insensitively, the name
+func matchesPrefix(value string, id uint, name string) bool {
+	return strings.HasPrefix(strconv.FormatUint(uint64(id), 10), value) || hasPrefixFold(name, value)
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return strings.HasPrefix(strings.ToLower(s), strings.ToLower(prefix))
+}
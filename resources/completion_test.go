@@ -0,0 +1,147 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+var testUserID = "test-user-id"
+
+func setupTestDB(t *testing.T) *database.Database {
+	db, err := database.NewDatabase(":memory:")
+	if err != nil {
+		t.Fatalf("Failed to create test database: %v", err)
+	}
+	return db
+}
+
+// createTestContext creates a context with a mock authenticated user for testing
+func createTestContext() context.Context {
+	user := &types.AuthenticatedUser{
+		Sub: testUserID,
+	}
+	return types.WithAuthenticatedUser(context.Background(), user)
+}
+
+func createTestResume(t *testing.T, db *database.Database, name string, userID string) *models.Resume {
+	resume := &models.Resume{
+		Name:        name,
+		Description: "Test Description",
+	}
+	if err := db.CreateResume(resume, &userID); err != nil {
+		t.Fatalf("Failed to create test resume: %v", err)
+	}
+	return resume
+}
+
+func createTestTemplate(t *testing.T, db *database.Database, resumeID uint, name string) *models.Template {
+	template := &models.Template{
+		ResumeID:     &resumeID,
+		Name:         name,
+		TemplateData: "<h1>{{.Name}}</h1>",
+	}
+	if err := db.CreateTemplate(template, &testUserID); err != nil {
+		t.Fatalf("Failed to create test template: %v", err)
+	}
+	return template
+}
+
+func TestCompletionProvider_ResumeID(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	createTestResume(t, db, "Backend Engineer", testUserID)
+	createTestResume(t, db, "Frontend Engineer", testUserID)
+	createTestResume(t, db, "Backend Other User", "other-user")
+
+	provider := NewCompletionProvider(db)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{name: "empty value lists all resumes of the user", value: "", expected: []string{"1", "2"}},
+		{name: "name prefix is case-insensitive", value: "back", expected: []string{"1"}},
+		{name: "id prefix", value: "2", expected: []string{"2"}},
+		{name: "no match", value: "designer", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			completion, err := provider.CompleteResourceArgument(createTestContext(), ResumeURITemplate,
+				mcp.CompleteArgument{Name: "resume_id", Value: tt.value}, mcp.CompleteContext{})
+			if err != nil {
+				t.Fatalf("CompleteResourceArgument() error = %v", err)
+			}
+			if len(completion.Values) != len(tt.expected) {
+				t.Fatalf("Expected values %v, got %v", tt.expected, completion.Values)
+			}
+			for i, value := range tt.expected {
+				if completion.Values[i] != value {
+					t.Errorf("Expected values %v, got %v", tt.expected, completion.Values)
+				}
+			}
+		})
+	}
+}
+
+func TestCompletionProvider_TemplateIDScopedToResume(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume1 := createTestResume(t, db, "First", testUserID)
+	resume2 := createTestResume(t, db, "Second", testUserID)
+	createTestTemplate(t, db, resume1.ID, "Modern")
+	createTestTemplate(t, db, resume2.ID, "Minimal")
+
+	provider := NewCompletionProvider(db)
+
+	completion, err := provider.CompleteResourceArgument(createTestContext(), TemplateURITemplate,
+		mcp.CompleteArgument{Name: "template_id", Value: "m"}, mcp.CompleteContext{})
+	if err != nil {
+		t.Fatalf("CompleteResourceArgument() error = %v", err)
+	}
+	if len(completion.Values) != 2 {
+		t.Errorf("Expected 2 templates, got %v", completion.Values)
+	}
+
+	completion, err = provider.CompleteResourceArgument(createTestContext(), TemplateURITemplate,
+		mcp.CompleteArgument{Name: "template_id", Value: "m"},
+		mcp.CompleteContext{Arguments: map[string]string{"resume_id": "2"}})
+	if err != nil {
+		t.Fatalf("CompleteResourceArgument() error = %v", err)
+	}
+	if len(completion.Values) != 1 || completion.Values[0] != "2" {
+		t.Errorf("Expected only template 2, got %v", completion.Values)
+	}
+}
+
+func TestCompletionProvider_SessionID(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db, "Backend Engineer", testUserID)
+	sessionID, err := db.GeneratePreview(resume.ID, "<h1>{{.Name}}</h1>", "", &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to create preview session: %v", err)
+	}
+
+	provider := NewCompletionProvider(db)
+
+	for _, value := range []string{"", sessionID[:4], "backend"} {
+		completion, err := provider.CompleteResourceArgument(createTestContext(), PreviewURITemplate,
+			mcp.CompleteArgument{Name: "session_id", Value: value}, mcp.CompleteContext{})
+		if err != nil {
+			t.Fatalf("CompleteResourceArgument() error = %v", err)
+		}
+		if len(completion.Values) != 1 || completion.Values[0] != sessionID {
+			t.Errorf("Expected session %s for value %q, got %v", sessionID, value, completion.Values)
+		}
+	}
+}
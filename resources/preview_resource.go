@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+	"github.com/rxtech-lab/resume-mcp/internal/utils"
+)
+
+// PreviewURITemplate addresses a preview session created by generate_preview
+const PreviewURITemplate = "preview://{session_id}"
+
+// PreviewURI returns the resource URI of the preview session with the given ID
+func PreviewURI(sessionID string) string {
+	return "preview://" + sessionID
+}
+
+func NewPreviewResourceTemplate(db *database.Database, port string) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+	template := mcp.NewResourceTemplate(PreviewURITemplate, "Preview session",
+		mcp.WithTemplateDescription("A preview session with its template, CSS and preview/download URLs"),
+		mcp.WithTemplateMIMEType("application/json"),
+	)
+
+	handler := func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		sessionID := uriArgument(request, "session_id")
+		session, err := db.GetPreviewSession(sessionID, userID)
+		if err != nil {
+			return nil, fmt.Errorf("preview session not found: %w", err)
+		}
+
+		previewURL, err := utils.GetTransactionSessionUrl(port, sessionID)
+		if err != nil {
+			return nil, err
+		}
+
+		downloadURL, err := utils.GetDownloadSessionUrl(port, sessionID)
+		if err != nil {
+			return nil, err
+		}
+
+		result := map[string]any{
+			"id":           session.ID,
+			"resume_id":    session.ResumeID,
+			"template":     session.Template,
+			"css":          session.CSS,
+			"created_at":   session.CreatedAt,
+			"preview_url":  previewURL,
+			"download_url": downloadURL,
+		}
+
+		resultJSON, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode preview session: %w", err)
+		}
+
+		return []mcp.ResourceContents{
+			mcp.TextResourceContents{
+				URI:      request.Params.URI,
+				MIMEType: "application/json",
+				Text:     string(resultJSON),
+			},
+		}, nil
+	}
+
+	return template, handler
+}
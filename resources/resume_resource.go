@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+// ResumeURITemplate addresses a single resume with all of its related data
+const ResumeURITemplate = "resume://{resume_id}"
+
+// ResumeURI returns the resource URI of the resume with the given ID
+func ResumeURI(resumeID uint) string {
+	return fmt.Sprintf("resume://%d", resumeID)
+}
+
+func NewResumeResourceTemplate(db *database.Database) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+	template := mcp.NewResourceTemplate(ResumeURITemplate, "Resume",
+		mcp.WithTemplateDescription("Complete structured resume data including contacts, experiences, education and feature maps"),
+		mcp.WithTemplateMIMEType("application/json"),
+	)
+
+	handler := func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		resumeID, err := strconv.ParseUint(uriArgument(request, "resume_id"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resume_id: %w", err)
+		}
+
+		resume, err := db.GetResumeByID(uint(resumeID), userID)
+		if err != nil {
+			return nil, fmt.Errorf("resume not found: %w", err)
+		}
+
+		resultJSON, err := json.Marshal(resume)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode resume: %w", err)
+		}
+
+		return []mcp.ResourceContents{
+			mcp.TextResourceContents{
+				URI:      request.Params.URI,
+				MIMEType: "application/json",
+				Text:     string(resultJSON),
+			},
+		}, nil
+	}
+
+	return template, handler
+}
+
+// uriArgument returns a variable matched from the resource URI template
+func uriArgument(request mcp.ReadResourceRequest, name string) string {
+	switch value := request.Params.Arguments[name].(type) {
+	case []string:
+		if len(value) > 0 {
+			return value[0]
+		}
+	case string:
+		return value
+	}
+	return ""
+}
@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func createReadRequest(uri string, arguments map[string]any) mcp.ReadResourceRequest {
+	request := mcp.ReadResourceRequest{}
+	request.Params.URI = uri
+	request.Params.Arguments = arguments
+	return request
+}
+
+func TestResumeResourceTemplate_Read(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	createTestResume(t, db, "Backend Engineer", testUserID)
+
+	template, handler := NewResumeResourceTemplate(db)
+	if template.URITemplate.Raw() != ResumeURITemplate {
+		t.Errorf("Expected URI template %s, got %s", ResumeURITemplate, template.URITemplate.Raw())
+	}
+
+	contents, err := handler(createTestContext(), createReadRequest("resume://1", map[string]any{"resume_id": []string{"1"}}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	text, ok := contents[0].(mcp.TextResourceContents)
+	if !ok {
+		t.Fatalf("Expected TextResourceContents, got %T", contents[0])
+	}
+	if !strings.Contains(text.Text, "Backend Engineer") {
+		t.Errorf("Expected resume JSON, got %s", text.Text)
+	}
+}
+
+func TestResumeResourceTemplate_OtherUser(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	createTestResume(t, db, "Someone Else", "other-user")
+
+	_, handler := NewResumeResourceTemplate(db)
+	if _, err := handler(createTestContext(), createReadRequest("resume://1", map[string]any{"resume_id": []string{"1"}})); err == nil {
+		t.Error("Expected error when reading another user's resume")
+	}
+}
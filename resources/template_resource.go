@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+// TemplateURITemplate addresses a single resume template
+const TemplateURITemplate = "template://{template_id}"
+
+// TemplateURI returns the resource URI of the template with the given ID
+func TemplateURI(templateID uint) string {
+	return fmt.Sprintf("template://%d", templateID)
+}
+
+func NewTemplateResourceTemplate(db *database.Database) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+	template := mcp.NewResourceTemplate(TemplateURITemplate, "Template",
+		mcp.WithTemplateDescription("A saved Go template used to render a resume"),
+		mcp.WithTemplateMIMEType("application/json"),
+	)
+
+	handler := func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		templateID, err := strconv.ParseUint(uriArgument(request, "template_id"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template_id: %w", err)
+		}
+
+		tmpl, err := db.GetTemplateByID(uint(templateID), userID)
+		if err != nil {
+			return nil, fmt.Errorf("template not found: %w", err)
+		}
+
+		resultJSON, err := json.Marshal(tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode template: %w", err)
+		}
+
+		return []mcp.ResourceContents{
+			mcp.TextResourceContents{
+				URI:      request.Params.URI,
+				MIMEType: "application/json",
+				Text:     string(resultJSON),
+			},
+		}, nil
+	}
+
+	return template, handler
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -36,10 +35,10 @@ func NewAddEducationTool(db *database.Database) (mcp.Tool, server.ToolHandlerFun
 		),
 		mcp.WithString("start_date",
 			mcp.Required(),
-			mcp.Description("Start date in YYYY-MM-DD format"),
+			mcp.Description("Start date in YYYY, YYYY-MM or YYYY-MM-DD format, only give what is known"),
 		),
 		mcp.WithString("end_date",
-			mcp.Description("End date in YYYY-MM-DD format (optional for current education)"),
+			mcp.Description("End date in YYYY, YYYY-MM or YYYY-MM-DD format, or \"present\" (optional for current education)"),
 		),
 	)
 
@@ -73,19 +72,14 @@ func NewAddEducationTool(db *database.Database) (mcp.Tool, server.ToolHandlerFun
 			return nil, fmt.Errorf("start_date parameter is required: %w", err)
 		}
 
-		startDate, err := time.Parse("2006-01-02", startDateStr)
+		startDate, err := models.ParsePartialDate(startDateStr)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Invalid start_date format: %v", err)), nil
 		}
 
-		endDateStr := request.GetString("end_date", "")
-		var endDate *time.Time
-		if endDateStr != "" {
-			parsedEndDate, err := time.Parse("2006-01-02", endDateStr)
-			if err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("Invalid end_date format: %v", err)), nil
-			}
-			endDate = &parsedEndDate
+		endDate, err := models.ParseEndDate(request.GetString("end_date", ""))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid end_date format: %v", err)), nil
 		}
 
 		category, err := request.RequireString("category")
@@ -94,12 +88,17 @@ func NewAddEducationTool(db *database.Database) (mcp.Tool, server.ToolHandlerFun
 		}
 
 		education := &models.Education{
-			ResumeID:   uint(resumeID),
-			SchoolName: schoolName,
-			Type:       eduType,
-			StartDate:  startDate,
-			EndDate:    endDate,
-			Category:   category,
+			ResumeID:           uint(resumeID),
+			SchoolName:         schoolName,
+			Type:               eduType,
+			StartDate:          startDate.Time,
+			StartDatePrecision: startDate.Precision,
+			Category:           category,
+		}
+
+		if endDate != nil {
+			education.EndDate = &endDate.Time
+			education.EndDatePrecision = endDate.Precision
 		}
 
 		if err := db.AddEducation(education, userID); err != nil {
@@ -111,11 +110,11 @@ func NewAddEducationTool(db *database.Database) (mcp.Tool, server.ToolHandlerFun
 			"resume_id":   education.ResumeID,
 			"school_name": education.SchoolName,
 			"type":        education.Type,
-			"start_date":  education.StartDate.Format("2006-01-02"),
+			"start_date":  education.Start().String(),
 		}
 
 		if education.EndDate != nil {
-			result["end_date"] = education.EndDate.Format("2006-01-02")
+			result["end_date"] = education.End().String()
 		}
 
 		return mcp.NewToolResultText(fmt.Sprintf("Education added successfully")), nil
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -36,10 +35,10 @@ func NewAddWorkExperienceTool(db *database.Database) (mcp.Tool, server.ToolHandl
 		),
 		mcp.WithString("start_date",
 			mcp.Required(),
-			mcp.Description("Start date in YYYY-MM-DD format"),
+			mcp.Description("Start date in YYYY, YYYY-MM or YYYY-MM-DD format, only give what is known"),
 		),
 		mcp.WithString("end_date",
-			mcp.Description("End date in YYYY-MM-DD format (optional for current job)"),
+			mcp.Description("End date in YYYY, YYYY-MM or YYYY-MM-DD format, or \"present\" (optional for current job)"),
 		),
 	)
 
@@ -78,28 +77,28 @@ func NewAddWorkExperienceTool(db *database.Database) (mcp.Tool, server.ToolHandl
 			return nil, fmt.Errorf("start_date parameter is required: %w", err)
 		}
 
-		startDate, err := time.Parse("2006-01-02", startDateStr)
+		startDate, err := models.ParsePartialDate(startDateStr)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Invalid start_date format: %v", err)), nil
 		}
 
-		endDateStr := request.GetString("end_date", "")
-		var endDate *time.Time
-		if endDateStr != "" {
-			parsedEndDate, err := time.Parse("2006-01-02", endDateStr)
-			if err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("Invalid end_date format: %v", err)), nil
-			}
-			endDate = &parsedEndDate
+		endDate, err := models.ParseEndDate(request.GetString("end_date", ""))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid end_date format: %v", err)), nil
 		}
 
 		workExp := &models.WorkExperience{
-			ResumeID:  uint(resumeID),
-			Company:   company,
-			JobTitle:  jobTitle,
-			Type:      workType,
-			StartDate: startDate,
-			EndDate:   endDate,
+			ResumeID:           uint(resumeID),
+			Company:            company,
+			JobTitle:           jobTitle,
+			Type:               workType,
+			StartDate:          startDate.Time,
+			StartDatePrecision: startDate.Precision,
+		}
+
+		if endDate != nil {
+			workExp.EndDate = &endDate.Time
+			workExp.EndDatePrecision = endDate.Precision
 		}
 
 		if err := db.AddWorkExperience(workExp, userID); err != nil {
@@ -112,11 +111,11 @@ func NewAddWorkExperienceTool(db *database.Database) (mcp.Tool, server.ToolHandl
 			"company":    workExp.Company,
 			"job_title":  workExp.JobTitle,
 			"type":       workExp.Type,
-			"start_date": workExp.StartDate.Format("2006-01-02"),
+			"start_date": workExp.Start().String(),
 		}
 
 		if workExp.EndDate != nil {
-			result["end_date"] = workExp.EndDate.Format("2006-01-02")
+			result["end_date"] = workExp.End().String()
 		}
 
 		return mcp.NewToolResultText(fmt.Sprintf("Work experience added successfully")), nil
@@ -0,0 +1,145 @@
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func TestAddWorkExperienceTool_PartialDates(t *testing.T) {
+	tests := []struct {
+		name           string
+		startDate      string
+		endDate        string
+		expectedStart  time.Time
+		startPrecision string
+		expectedEnd    *time.Time
+		endPrecision   string
+	}{
+		{
+			name:           "year precision",
+			startDate:      "2018",
+			endDate:        "2020",
+			expectedStart:  time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			startPrecision: models.DatePrecisionYear,
+			expectedEnd:    ptrTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			endPrecision:   models.DatePrecisionYear,
+		},
+		{
+			name:           "month and day precision",
+			startDate:      "2018-03",
+			endDate:        "2020-11-30",
+			expectedStart:  time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC),
+			startPrecision: models.DatePrecisionMonth,
+			expectedEnd:    ptrTime(time.Date(2020, 11, 30, 0, 0, 0, 0, time.UTC)),
+			endPrecision:   models.DatePrecisionDay,
+		},
+		{
+			name:           "present",
+			startDate:      "2021-05",
+			endDate:        "Present",
+			expectedStart:  time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC),
+			startPrecision: models.DatePrecisionMonth,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupTestDB(t)
+			defer db.Close()
+			resume := createTestResume(t, db)
+
+			_, handler := NewAddWorkExperienceTool(db)
+			result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+				"resume_id":  "1",
+				"company":    "Tech Corp",
+				"job_title":  "Engineer",
+				"start_date": tt.startDate,
+				"end_date":   tt.endDate,
+			}))
+			if err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Expected success, got error: %v", result.Content[0].(mcp.TextContent).Text)
+			}
+
+			stored, err := db.GetResumeByID(resume.ID, &testUserID)
+			if err != nil {
+				t.Fatalf("Failed to get resume: %v", err)
+			}
+			experience := stored.WorkExperiences[0]
+			if !experience.StartDate.Equal(tt.expectedStart) || experience.StartDatePrecision != tt.startPrecision {
+				t.Errorf("Expected start %v (%s), got %v (%s)", tt.expectedStart, tt.startPrecision, experience.StartDate, experience.StartDatePrecision)
+			}
+			if tt.expectedEnd == nil {
+				if !experience.Current() {
+					t.Errorf("Expected current role, got end date %v", experience.EndDate)
+				}
+				return
+			}
+			if experience.EndDate == nil || !experience.EndDate.Equal(*tt.expectedEnd) || experience.EndDatePrecision != tt.endPrecision {
+				t.Errorf("Expected end %v (%s), got %v (%s)", tt.expectedEnd, tt.endPrecision, experience.EndDate, experience.EndDatePrecision)
+			}
+		})
+	}
+}
+
+func TestAddWorkExperienceTool_InvalidDates(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	createTestResume(t, db)
+
+	_, handler := NewAddWorkExperienceTool(db)
+	for _, dates := range [][2]string{{"03/2018", ""}, {"2018-13", ""}, {"2018", "soon"}, {"18", ""}} {
+		result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+			"resume_id":  "1",
+			"company":    "Tech Corp",
+			"job_title":  "Engineer",
+			"start_date": dates[0],
+			"end_date":   dates[1],
+		}))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected error for dates %v", dates)
+		}
+	}
+}
+
+func TestAddEducationTool_PartialDates(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	resume := createTestResume(t, db)
+
+	_, handler := NewAddEducationTool(db)
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id":   "1",
+		"school_name": "University",
+		"category":    "degree",
+		"start_date":  "2014",
+		"end_date":    "2018-06",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error: %v", result.Content[0].(mcp.TextContent).Text)
+	}
+
+	stored, err := db.GetResumeByID(resume.ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get resume: %v", err)
+	}
+	education := stored.Educations[0]
+	if education.Start().String() != "2014" || education.End().String() != "2018-06" {
+		t.Errorf("Expected 2014 to 2018-06, got %s to %s", education.Start(), education.End())
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,76 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/analysis"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewAnalyzeJobMatchTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("analyze_job_match",
+		mcp.WithDescription(`Check how well a resume covers the keywords of a job description.
+
+Keywords and two word phrases are extracted offline from the job description by tokenizing it
+and removing common English and job posting stop words. Each keyword is then looked up in the
+resume's description, job titles, company and school names and feature maps.
+
+The result lists covered keywords with the resume entries that contain them, missing keywords,
+and a coverage score from 0 to 100 weighted by how often each keyword appears in the job description.`),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("ID of the resume to analyze"),
+		),
+		mcp.WithString("job_description",
+			mcp.Required(),
+			mcp.Description("Full text of the job description"),
+		),
+		mcp.WithNumber("max_keywords",
+			mcp.Description(fmt.Sprintf("Maximum number of keywords to analyze, most frequent first (default %d)", analysis.DefaultMaxKeywords)),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		resumeIDStr, err := request.RequireString("resume_id")
+		if err != nil {
+			return nil, fmt.Errorf("resume_id parameter is required: %w", err)
+		}
+
+		jobDescription, err := request.RequireString("job_description")
+		if err != nil {
+			return nil, fmt.Errorf("job_description parameter is required: %w", err)
+		}
+
+		if strings.TrimSpace(jobDescription) == "" {
+			return mcp.NewToolResultError("job_description must not be empty"), nil
+		}
+
+		resumeID, err := strconv.ParseUint(resumeIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id: %v", err)), nil
+		}
+
+		resume, err := db.GetResumeByID(uint(resumeID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting resume: %v", err)), nil
+		}
+
+		maxKeywords := request.GetInt("max_keywords", analysis.DefaultMaxKeywords)
+		match := analysis.AnalyzeJobMatch(resume, jobDescription, maxKeywords)
+
+		resultJSON, _ := json.Marshal(match)
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,98 @@
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/analysis"
+)
+
+func TestAnalyzeJobMatchTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	createFullTestResume(t, db)
+
+	tool, handler := NewAnalyzeJobMatchTool(db)
+	if tool.Name != "analyze_job_match" {
+		t.Errorf("Expected tool name 'analyze_job_match', got %s", tool.Name)
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id":       "1",
+		"job_description": "Software Engineer with Go and Python. Kubernetes experience required.",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("Expected TextContent, got %T", result.Content[0])
+	}
+
+	var match analysis.JobMatch
+	if err := json.Unmarshal([]byte(textContent.Text), &match); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+
+	missing := map[string]bool{}
+	for _, k := range match.Missing {
+		missing[k.Term] = true
+	}
+	if !missing["kubernetes"] {
+		t.Errorf("Expected kubernetes to be missing, got %+v", match.Missing)
+	}
+	if missing["go"] || missing["python"] {
+		t.Errorf("Expected skills from feature maps to be covered, got %+v", match.Missing)
+	}
+	if match.Score <= 0 || match.Score >= 100 {
+		t.Errorf("Expected partial coverage score, got %v", match.Score)
+	}
+}
+
+func TestAnalyzeJobMatchTool_Errors(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	createTestResume(t, db)
+	_, handler := NewAnalyzeJobMatchTool(db)
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:          "empty job description",
+			args:          map[string]interface{}{"resume_id": "1", "job_description": "  "},
+			expectedError: "job_description must not be empty",
+		},
+		{
+			name:          "invalid resume_id",
+			args:          map[string]interface{}{"resume_id": "abc", "job_description": "Go"},
+			expectedError: "Invalid resume_id",
+		},
+		{
+			name:          "resume not found",
+			args:          map[string]interface{}{"resume_id": "999", "job_description": "Go"},
+			expectedError: "Error getting resume",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler(createTestContext(), createTestRequest(tt.args))
+			if err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+
+			textContent := result.Content[0].(mcp.TextContent)
+			if !strings.Contains(textContent.Text, tt.expectedError) {
+				t.Errorf("Expected '%s' error, got: %s", tt.expectedError, textContent.Text)
+			}
+		})
+	}
+}
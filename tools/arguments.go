@@ -0,0 +1,21 @@
+package tools
+
+import (
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// jsonArgument returns an argument given as JSON text, or as an array or object by clients that
+// decode JSON arguments. ok is false when the argument is missing.
+func jsonArgument(request mcp.CallToolRequest, name string) (data []byte, ok bool, err error) {
+	value, ok := request.GetArguments()[name]
+	if !ok || value == nil {
+		return nil, false, nil
+	}
+	if raw, isString := value.(string); isString {
+		return []byte(raw), true, nil
+	}
+	data, err = json.Marshal(value)
+	return data, true, err
+}
@@ -0,0 +1,35 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestJSONArgument(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments map[string]interface{}
+		expected  string
+		ok        bool
+	}{
+		{"JSON string", map[string]interface{}{"items": `[{"a":1}]`}, `[{"a":1}]`, true},
+		{"decoded array", map[string]interface{}{"items": []interface{}{map[string]interface{}{"a": 1}}}, `[{"a":1}]`, true},
+		{"decoded object", map[string]interface{}{"items": map[string]interface{}{"a": "b"}}, `{"a":"b"}`, true},
+		{"missing", map[string]interface{}{}, "", false},
+		{"null", map[string]interface{}{"items": nil}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, ok, err := jsonArgument(createTestRequest(tt.arguments), "items")
+			if err != nil {
+				t.Fatalf("jsonArgument() error = %v", err)
+			}
+			if ok != tt.ok {
+				t.Errorf("Expected ok %v, got %v", tt.ok, ok)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(data))
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// coverLetterSectionsSchema describes the items of the sections argument of the cover letter tools
+var coverLetterSectionsSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"heading": map[string]any{
+			"type":        "string",
+			"description": "Optional heading of the section",
+		},
+		"body": map[string]any{
+			"type":        "string",
+			"description": "Text of the section, e.g. a paragraph",
+		},
+	},
+	"required": []string{"body"},
+}
+
+// sectionsArgument parses the sections argument, given either as an array or as a JSON string.
+// ok is false when the argument is missing.
+func sectionsArgument(request mcp.CallToolRequest) (sections []models.CoverLetterSection, ok bool, err error) {
+	raw, ok, err := jsonArgument(request, "sections")
+	if !ok || err != nil {
+		return nil, ok, err
+	}
+
+	var items []struct {
+		Heading string `json:"heading"`
+		Body    string `json:"body"`
+	}
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, true, fmt.Errorf("sections must be an array of objects with heading and body: %w", err)
+	}
+
+	for i, item := range items {
+		if strings.TrimSpace(item.Body) == "" {
+			return nil, true, fmt.Errorf("section %d has no body", i+1)
+		}
+		sections = append(sections, models.CoverLetterSection{
+			Position: i,
+			Heading:  item.Heading,
+			Body:     item.Body,
+		})
+	}
+	return sections, true, nil
+}
+
+// templateSampleData returns the data a template of the given type is validated against
+func templateSampleData(templateType string, resume models.Resume) any {
+	if templateType != models.TemplateTypeCoverLetter {
+		return resume
+	}
+	return models.CoverLetter{
+		ResumeID:  resume.ID,
+		Recipient: "Hiring Manager",
+		Company:   "Example Inc.",
+		Date:      time.Now(),
+		Resume:    resume,
+		Sections: []models.CoverLetterSection{
+			{Position: 0, Body: "I am writing to apply for the open position."},
+			{Position: 1, Heading: "Why me", Body: "My experience matches the requirements of the role."},
+		},
+	}
+}
+
+// templateModelName names the model a template of the given type renders, for validation errors
+func templateModelName(templateType string) string {
+	if templateType == models.TemplateTypeCoverLetter {
+		return "cover letter"
+	}
+	return "resume"
+}
@@ -0,0 +1,104 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewCreateCoverLetterTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("create_cover_letter",
+		mcp.WithDescription("Create a cover letter for a resume. The letter's body is a list of sections, e.g. one per paragraph. Render it with a cover letter template using generate_cover_letter_preview."),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("ID of the resume the letter belongs to"),
+		),
+		mcp.WithString("company",
+			mcp.Required(),
+			mcp.Description("Company the letter is addressed to"),
+		),
+		mcp.WithString("recipient",
+			mcp.Description("Name of the recipient, e.g. the hiring manager (optional)"),
+		),
+		mcp.WithString("date",
+			mcp.Description("Date of the letter in YYYY-MM-DD format (default: today)"),
+		),
+		mcp.WithArray("sections",
+			mcp.Required(),
+			mcp.Description("Body sections of the letter in order"),
+			mcp.Items(coverLetterSectionsSchema),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		resumeIDStr, err := request.RequireString("resume_id")
+		if err != nil {
+			return nil, fmt.Errorf("resume_id parameter is required: %w", err)
+		}
+
+		resumeID, err := strconv.ParseUint(resumeIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id: %v", err)), nil
+		}
+
+		company, err := request.RequireString("company")
+		if err != nil {
+			return nil, fmt.Errorf("company parameter is required: %w", err)
+		}
+
+		sections, ok, err := sectionsArgument(request)
+		if !ok {
+			return nil, fmt.Errorf("sections parameter is required")
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid sections: %v", err)), nil
+		}
+
+		date := time.Now().Truncate(24 * time.Hour)
+		if dateStr := request.GetString("date", ""); dateStr != "" {
+			date, err = time.Parse("2006-01-02", dateStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid date format: %v", err)), nil
+			}
+		}
+
+		if _, err := db.GetResumeByID(uint(resumeID), userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Resume not found: %v", err)), nil
+		}
+
+		letter := &models.CoverLetter{
+			ResumeID:  uint(resumeID),
+			Recipient: request.GetString("recipient", ""),
+			Company:   company,
+			Date:      date,
+			Sections:  sections,
+		}
+
+		if err := db.CreateCoverLetter(letter, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create cover letter: %v", err)), nil
+		}
+
+		result := map[string]any{
+			"cover_letter_id": letter.ID,
+			"resume_id":       letter.ResumeID,
+			"company":         letter.Company,
+			"sections":        len(letter.Sections),
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return mcp.NewToolResultText(fmt.Sprintf("Cover letter created successfully: %s", string(resultJSON))), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,131 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+// createTestCoverLetter creates a cover letter with two sections for the given resume
+func createTestCoverLetter(t *testing.T, db *database.Database, resumeID uint) *models.CoverLetter {
+	letter := &models.CoverLetter{
+		ResumeID:  resumeID,
+		Recipient: "Jane Smith",
+		Company:   "Acme Corp",
+		Sections: []models.CoverLetterSection{
+			{Position: 0, Body: "I am excited to apply."},
+			{Position: 1, Heading: "Experience", Body: "I built many things."},
+		},
+	}
+	if err := db.CreateCoverLetter(letter, &testUserID); err != nil {
+		t.Fatalf("Failed to create test cover letter: %v", err)
+	}
+	return letter
+}
+
+func TestCreateCoverLetterTool_Success(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	tool, handler := NewCreateCoverLetterTool(db)
+
+	if tool.Name != "create_cover_letter" {
+		t.Errorf("Expected tool name 'create_cover_letter', got %s", tool.Name)
+	}
+
+	request := createTestRequest(map[string]interface{}{
+		"resume_id": "1",
+		"company":   "Acme Corp",
+		"recipient": "Jane Smith",
+		"date":      "2024-03-01",
+		"sections": []interface{}{
+			map[string]interface{}{"body": "I am excited to apply."},
+			map[string]interface{}{"heading": "Experience", "body": "I built many things."},
+		},
+	})
+
+	result, err := handler(createTestContext(), request)
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got: %v", result.Content[0].(mcp.TextContent).Text)
+	}
+
+	letters, err := db.ListCoverLettersByResumeID(resume.ID, &testUserID)
+	if err != nil || len(letters) != 1 {
+		t.Fatalf("Expected one cover letter, got %d (%v)", len(letters), err)
+	}
+
+	letter, err := db.GetCoverLetterByID(letters[0].ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get cover letter: %v", err)
+	}
+	if letter.Company != "Acme Corp" || letter.Recipient != "Jane Smith" || letter.Date.Format("2006-01-02") != "2024-03-01" {
+		t.Errorf("Unexpected cover letter: %+v", letter)
+	}
+	if len(letter.Sections) != 2 || letter.Sections[1].Heading != "Experience" {
+		t.Errorf("Expected sections in order, got %+v", letter.Sections)
+	}
+	if letter.Resume.Name != resume.Name {
+		t.Errorf("Expected the resume to be loaded with the letter")
+	}
+}
+
+func TestCreateCoverLetterTool_Errors(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	createTestResume(t, db)
+	_, handler := NewCreateCoverLetterTool(db)
+
+	sections := []interface{}{map[string]interface{}{"body": "Hello"}}
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:          "resume not found",
+			args:          map[string]interface{}{"resume_id": "999", "company": "Acme", "sections": sections},
+			expectedError: "Resume not found",
+		},
+		{
+			name:          "invalid date",
+			args:          map[string]interface{}{"resume_id": "1", "company": "Acme", "date": "March 1st", "sections": sections},
+			expectedError: "Invalid date format",
+		},
+		{
+			name:          "section without body",
+			args:          map[string]interface{}{"resume_id": "1", "company": "Acme", "sections": []interface{}{map[string]interface{}{"heading": "Hi"}}},
+			expectedError: "section 1 has no body",
+		},
+		{
+			name:          "sections as JSON string",
+			args:          map[string]interface{}{"resume_id": "1", "company": "Acme", "sections": `[{"body": ""}]`},
+			expectedError: "section 1 has no body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler(createTestContext(), createTestRequest(tt.args))
+			if err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+
+			textContent := result.Content[0].(mcp.TextContent)
+			if !strings.Contains(textContent.Text, tt.expectedError) {
+				t.Errorf("Expected '%s' error, got: %s", tt.expectedError, textContent.Text)
+			}
+		})
+	}
+
+	if _, err := handler(createTestContext(), createTestRequest(map[string]interface{}{"resume_id": "1", "company": "Acme"})); err == nil {
+		t.Error("Expected error for missing sections")
+	}
+}
@@ -87,12 +87,14 @@ func NewCreateResumeTool(db *database.Database) (mcp.Tool, server.ToolHandlerFun
 			// Copy work experiences
 			for _, workExp := range sourceResume.WorkExperiences {
 				newWorkExp := &models.WorkExperience{
-					ResumeID:  resume.ID,
-					Company:   workExp.Company,
-					JobTitle:  workExp.JobTitle,
-					Type:      workExp.Type,
-					StartDate: workExp.StartDate,
-					EndDate:   workExp.EndDate,
+					ResumeID:           resume.ID,
+					Company:            workExp.Company,
+					JobTitle:           workExp.JobTitle,
+					Type:               workExp.Type,
+					StartDate:          workExp.StartDate,
+					EndDate:            workExp.EndDate,
+					StartDatePrecision: workExp.StartDatePrecision,
+					EndDatePrecision:   workExp.EndDatePrecision,
 				}
 				if err := db.AddWorkExperience(newWorkExp, userID); err != nil {
 					return mcp.NewToolResultError(fmt.Sprintf("Error copying work experience: %v", err)), nil
@@ -114,11 +116,13 @@ func NewCreateResumeTool(db *database.Database) (mcp.Tool, server.ToolHandlerFun
 			// Copy education
 			for _, education := range sourceResume.Educations {
 				newEducation := &models.Education{
-					ResumeID:   resume.ID,
-					SchoolName: education.SchoolName,
-					Type:       education.Type,
-					StartDate:  education.StartDate,
-					EndDate:    education.EndDate,
+					ResumeID:           resume.ID,
+					SchoolName:         education.SchoolName,
+					Type:               education.Type,
+					StartDate:          education.StartDate,
+					EndDate:            education.EndDate,
+					StartDatePrecision: education.StartDatePrecision,
+					EndDatePrecision:   education.EndDatePrecision,
 				}
 				if err := db.AddEducation(newEducation, userID); err != nil {
 					return mcp.NewToolResultError(fmt.Sprintf("Error copying education: %v", err)), nil
@@ -168,9 +172,11 @@ func NewCreateResumeTool(db *database.Database) (mcp.Tool, server.ToolHandlerFun
 
 			for _, template := range sourceTemplates {
 				newTemplate := &models.Template{
-					ResumeID:     resume.ID,
+					ResumeID:     &resume.ID,
 					Name:         template.Name,
 					Description:  template.Description,
+					Type:         template.Type,
+					Format:       template.Format,
 					TemplateData: template.TemplateData,
 				}
 				if err := db.CreateTemplate(newTemplate, userID); err != nil {
@@ -37,7 +37,7 @@ Example template:
     {{range .WorkExperiences}}
     <div class="mb-4">
       <h3 class="font-semibold">{{.JobTitle}} at {{.Company}}</h3>
-      <p class="text-sm text-gray-600">{{.StartDate.Format "Jan 2006"}} - {{if .EndDate}}{{.EndDate.Format "Jan 2006"}}{{else}}Present{{end}}</p>
+      <p class="text-sm text-gray-600">{{dateRange . "Jan 2006"}}</p>
       {{range .FeatureMaps}}
       <p>{{.Key}}: {{.Value}}</p>
       {{end}}
@@ -45,7 +45,21 @@ Example template:
     {{end}}
   </div>
   {{end}}
-</div>`),
+</div>
+
+Dates of work experiences and educations may only be known to the year or month. Render them with
+{{dateRange . "Jan 2006"}} or {{formatDate .Start "Jan 2006"}} and {{if .Current}}Present{{else}}{{formatDate .End "Jan 2006"}}{{end}}
+instead of .StartDate.Format, which would print invented days and months.
+
+Templates can include the user's partials with {{template "name" .}} and extend a base layout partial
+by rendering it and defining its sections, see create_partial.
+
+Set format to latex for a LaTeX template, exported with export_latex. Every value a LaTeX template prints is
+escaped for LaTeX, markdown converts Markdown to LaTeX and raw prints a value holding LaTeX as it is:
+\section*{ {{.Name}} }
+{{range .WorkExperiences}}\textbf{ {{.JobTitle}} } \hfill {{dateRange . "Jan 2006"}}
+{{range .FeatureMaps}}{{markdown .Value}}
+{{end}}{{end}}`),
 		mcp.WithString("resume_id",
 			mcp.Required(),
 			mcp.Description("ID of the resume which this template is based on"),
@@ -62,7 +76,15 @@ Example template:
 		),
 		mcp.WithString("template_data",
 			mcp.Required(),
-			mcp.Description("Go template string for rendering the resume HTML"),
+			mcp.Description("Go template string for rendering the resume HTML, or LaTeX for latex templates"),
+		),
+		mcp.WithString("type",
+			mcp.Description("Template type: resume or cover_letter (default: resume). Cover letter templates render a cover letter with .Recipient, .Company, .Date, .Sections (each with .Heading and .Body) and the resume as .Resume"),
+			mcp.Enum(models.TemplateTypeResume, models.TemplateTypeCoverLetter),
+		),
+		mcp.WithString("format",
+			mcp.Description("Template format: html or latex (default: html)"),
+			mcp.Enum(models.TemplateFormatHTML, models.TemplateFormatLaTeX),
 		),
 	)
 
@@ -70,6 +92,11 @@ Example template:
 		user := types.GetAuthenticatedUser(ctx)
 		userID := &user.Sub
 
+		templateService, err := userTemplateService(db, templateService, userID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		resumeIDStr, err := request.RequireString("resume_id")
 		if err != nil {
 			return nil, fmt.Errorf("resume_id parameter is required: %w", err)
@@ -94,6 +121,16 @@ Example template:
 
 		description := request.GetString("description", "")
 
+		templateType := request.GetString("type", models.TemplateTypeResume)
+		if templateType != models.TemplateTypeResume && templateType != models.TemplateTypeCoverLetter {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid type: %s, must be resume or cover_letter", templateType)), nil
+		}
+
+		format := request.GetString("format", models.TemplateFormatHTML)
+		if format != models.TemplateFormatHTML && format != models.TemplateFormatLaTeX {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid format: %s, must be html or latex", format)), nil
+		}
+
 		// Validate resume exists
 		resume, err := db.GetResumeByID(uint(resumeID), userID)
 		if err != nil {
@@ -116,11 +153,13 @@ Example template:
 			// Copy education
 			for _, education := range sourceResume.Educations {
 				newEducation := &models.Education{
-					ResumeID:   uint(resumeID),
-					SchoolName: education.SchoolName,
-					Type:       education.Type,
-					StartDate:  education.StartDate,
-					EndDate:    education.EndDate,
+					ResumeID:           uint(resumeID),
+					SchoolName:         education.SchoolName,
+					Type:               education.Type,
+					StartDate:          education.StartDate,
+					EndDate:            education.EndDate,
+					StartDatePrecision: education.StartDatePrecision,
+					EndDatePrecision:   education.EndDatePrecision,
 				}
 				if err := db.AddEducation(newEducation, userID); err != nil {
 					return mcp.NewToolResultError(fmt.Sprintf("Error copying education: %v", err)), nil
@@ -142,12 +181,14 @@ Example template:
 			// Copy work experiences
 			for _, workExp := range sourceResume.WorkExperiences {
 				newWorkExp := &models.WorkExperience{
-					ResumeID:  uint(resumeID),
-					Company:   workExp.Company,
-					JobTitle:  workExp.JobTitle,
-					Type:      workExp.Type,
-					StartDate: workExp.StartDate,
-					EndDate:   workExp.EndDate,
+					ResumeID:           uint(resumeID),
+					Company:            workExp.Company,
+					JobTitle:           workExp.JobTitle,
+					Type:               workExp.Type,
+					StartDate:          workExp.StartDate,
+					EndDate:            workExp.EndDate,
+					StartDatePrecision: workExp.StartDatePrecision,
+					EndDatePrecision:   workExp.EndDatePrecision,
 				}
 				if err := db.AddWorkExperience(newWorkExp, userID); err != nil {
 					return mcp.NewToolResultError(fmt.Sprintf("Error copying work experience: %v", err)), nil
@@ -197,15 +238,17 @@ Example template:
 		}
 
 		// Validate template by testing it
-		_, err = templateService.GeneratePreview(templateData, "", *resume)
+		output, err := templateService.RenderTemplate(templateData, format, templateSampleData(templateType, *resume))
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Template validation failed: %v. Please check your Go template syntax and ensure all referenced fields exist on the resume model.", err)), nil
+			return mcp.NewToolResultError(fmt.Sprintf("Template validation failed: %v. Please check your Go template syntax and ensure all referenced fields exist on the %s model.", err, templateModelName(templateType))), nil
 		}
 
 		template := &models.Template{
-			ResumeID:     uint(resumeID),
+			ResumeID:     &resume.ID,
 			Name:         name,
 			Description:  description,
+			Type:         templateType,
+			Format:       format,
 			TemplateData: templateData,
 		}
 
@@ -214,10 +257,10 @@ Example template:
 		}
 
 		if copyFromResumeIDStr != "" {
-			return mcp.NewToolResultText(fmt.Sprintf("Created template successfully and copied data from resume ID %s (copied_from_resume_id: %s)", copyFromResumeIDStr, copyFromResumeIDStr)), nil
+			return mcp.NewToolResultText(fmt.Sprintf("Created template successfully and copied data from resume ID %s (copied_from_resume_id: %s)", copyFromResumeIDStr, copyFromResumeIDStr) + unsupportedClassesWarning(format, output)), nil
 		}
 
-		return mcp.NewToolResultText("Created template successfully"), nil
+		return mcp.NewToolResultText("Created template successfully" + unsupportedClassesWarning(format, output)), nil
 	}
 
 	return tool, handler
@@ -115,8 +115,8 @@ func TestCreateTemplateTool_Success(t *testing.T) {
 	if template.Description != "A test template" {
 		t.Errorf("Expected description 'A test template', got %s", template.Description)
 	}
-	if template.ResumeID != resume.ID {
-		t.Errorf("Expected resume ID %d, got %d", resume.ID, template.ResumeID)
+	if template.ResumeID == nil || *template.ResumeID != resume.ID {
+		t.Errorf("Expected resume ID %d, got %v", resume.ID, template.ResumeID)
 	}
 }
 
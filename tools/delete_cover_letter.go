@@ -0,0 +1,51 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewDeleteCoverLetterTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_cover_letter",
+		mcp.WithDescription("Delete a cover letter and its sections by ID"),
+		mcp.WithString("cover_letter_id",
+			mcp.Required(),
+			mcp.Description("ID of the cover letter to delete"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		letterIDStr, err := request.RequireString("cover_letter_id")
+		if err != nil {
+			return nil, fmt.Errorf("cover_letter_id parameter is required: %w", err)
+		}
+
+		letterID, err := strconv.ParseUint(letterIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid cover_letter_id: %v", err)), nil
+		}
+
+		// Check if the cover letter exists first
+		letter, err := db.GetCoverLetterByID(uint(letterID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Cover letter not found: %v", err)), nil
+		}
+
+		if err := db.DeleteCoverLetter(uint(letterID), userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete cover letter: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Cover letter deleted successfully: %s", letter.Company)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,84 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/analysis"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewDiffResumesTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("diff_resumes",
+		mcp.WithDescription(`Compare two resumes entity by entity and return the differences as JSON.
+
+Entities are matched on natural keys instead of database IDs:
+- Contacts by key (e.g. email)
+- Work experiences by company and job title
+- Educations by school name
+- Other experiences by category
+- Feature maps by key within their matched experience
+
+Entries only in resume B are listed as added, entries only in resume A as removed.
+Entries in both with different fields or feature maps are listed as changed.`),
+		mcp.WithString("resume_id_a",
+			mcp.Required(),
+			mcp.Description("ID of the base resume, e.g. the master resume"),
+		),
+		mcp.WithString("resume_id_b",
+			mcp.Required(),
+			mcp.Description("ID of the resume to compare against the base resume"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		resumeIDAStr, err := request.RequireString("resume_id_a")
+		if err != nil {
+			return nil, fmt.Errorf("resume_id_a parameter is required: %w", err)
+		}
+
+		resumeIDBStr, err := request.RequireString("resume_id_b")
+		if err != nil {
+			return nil, fmt.Errorf("resume_id_b parameter is required: %w", err)
+		}
+
+		resumeIDA, err := strconv.ParseUint(resumeIDAStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id_a: %v", err)), nil
+		}
+
+		resumeIDB, err := strconv.ParseUint(resumeIDBStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id_b: %v", err)), nil
+		}
+
+		resumeA, err := db.GetResumeByID(uint(resumeIDA), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting resume %d: %v", resumeIDA, err)), nil
+		}
+
+		resumeB, err := db.GetResumeByID(uint(resumeIDB), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting resume %d: %v", resumeIDB, err)), nil
+		}
+
+		result := map[string]any{
+			"resume_a": map[string]any{"id": resumeA.ID, "name": resumeA.Name},
+			"resume_b": map[string]any{"id": resumeB.ID, "name": resumeB.Name},
+			"diff":     analysis.DiffResumes(resumeA, resumeB),
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func TestDiffResumesTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resumeA := createTestResume(t, db)
+	resumeB := createTestResume(t, db)
+	db.AddContact(&models.Contact{ResumeID: resumeA.ID, Key: "email", Value: "a@example.com"}, &testUserID)
+	db.AddContact(&models.Contact{ResumeID: resumeB.ID, Key: "email", Value: "b@example.com"}, &testUserID)
+	db.AddContact(&models.Contact{ResumeID: resumeB.ID, Key: "phone", Value: "123"}, &testUserID)
+
+	tool, handler := NewDiffResumesTool(db)
+	if tool.Name != "diff_resumes" {
+		t.Errorf("Expected tool name 'diff_resumes', got %s", tool.Name)
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id_a": "1",
+		"resume_id_b": "2",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("Expected TextContent, got %T", result.Content[0])
+	}
+
+	var response struct {
+		Diff struct {
+			Identical bool `json:"identical"`
+			Contacts  struct {
+				Added   []map[string]any `json:"added"`
+				Changed []map[string]any `json:"changed"`
+			} `json:"contacts"`
+		} `json:"diff"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if response.Diff.Identical {
+		t.Error("Expected resumes to differ")
+	}
+	if len(response.Diff.Contacts.Added) != 1 || len(response.Diff.Contacts.Changed) != 1 {
+		t.Errorf("Expected one added and one changed contact, got %s", textContent.Text)
+	}
+}
+
+func TestDiffResumesTool_ResumeNotFound(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	createTestResume(t, db)
+	_, handler := NewDiffResumesTool(db)
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id_a": "1",
+		"resume_id_b": "999",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	textContent := result.Content[0].(mcp.TextContent)
+	if !result.IsError || !strings.Contains(textContent.Text, "Error getting resume 999") {
+		t.Errorf("Expected resume not found error, got: %s", textContent.Text)
+	}
+}
@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/docx"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+	"github.com/rxtech-lab/resume-mcp/internal/utils"
+)
+
+// docxStylesSchema describes the properties of the styles argument of export_docx
+var docxStylesSchema = map[string]any{
+	"font_family":       map[string]any{"type": "string", "description": "Font of the document (default: Calibri)"},
+	"font_size":         map[string]any{"type": "number", "description": "Body font size in points (default: 10.5)"},
+	"name_size":         map[string]any{"type": "number", "description": "Font size of the name in points (default: 22)"},
+	"header_align":      map[string]any{"type": "string", "enum": []string{"left", "center"}, "description": "Alignment of the name and contacts (default: left)"},
+	"heading_size":      map[string]any{"type": "number", "description": "Font size of section headings in points (default: 12)"},
+	"heading_color":     map[string]any{"type": "string", "description": "Hex color of section headings (default: 1F2937)"},
+	"heading_uppercase": map[string]any{"type": "boolean", "description": "Print section headings in capitals (default: true)"},
+	"heading_rule":      map[string]any{"type": "boolean", "description": "Draw a line below section headings (default: true)"},
+	"bullet":            map[string]any{"type": "string", "description": "Bullet character of lists (default: •)"},
+	"date_layout":       map[string]any{"type": "string", "description": `Go time layout of dates, e.g. "01/2006" (default: "Jan 2006")`},
+	"date_position":     map[string]any{"type": "string", "enum": []string{"right", "below"}, "description": "Print dates at the right margin of the title line or below it (default: right)"},
+	"date_italic":       map[string]any{"type": "boolean", "description": "Print dates in italics (default: true)"},
+	"date_color":        map[string]any{"type": "string", "description": "Hex color of dates and contacts (default: 4B5563)"},
+	"page_size":         map[string]any{"type": "string", "enum": []string{"letter", "a4"}, "description": "Paper size (default: letter)"},
+	"margin":            map[string]any{"type": "number", "description": "Page margin in inches (default: 0.75)"},
+}
+
+func NewExportDocxTool(db *database.Database, port string) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("export_docx",
+		mcp.WithDescription("Export a resume or a cover letter as a Word document (DOCX), for employers and recruiters that ask for an editable file. The document is built from the resume data with real headings, bullet lists and dates instead of a template, and returned as an embedded resource with a download URL that serves the same document."),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("ID of the resume to export"),
+		),
+		mcp.WithString("cover_letter_id",
+			mcp.Description("ID of a cover letter of the resume to export instead of the resume (optional)"),
+		),
+		mcp.WithObject("styles",
+			mcp.Description("Styles of the document, options that aren't given keep their default (optional)"),
+			mcp.Properties(docxStylesSchema),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		styles, stylesJSON, err := docxStylesArgument(request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		resume, data, result, err := exportData(db, request, userID)
+		if result != nil || err != nil {
+			return result, err
+		}
+
+		file, err := docx.Write(data, styles)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error exporting docx: %v", err)), nil
+		}
+
+		session := &models.PreviewSession{
+			ID:         uuid.New().String(),
+			ResumeID:   resume.ID,
+			DocxStyles: string(stylesJSON),
+		}
+		if letter, ok := data.(models.CoverLetter); ok {
+			session.CoverLetterID = &letter.ID
+		}
+		if err := db.CreatePreviewSession(session, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error exporting docx: %v", err)), nil
+		}
+
+		downloadURL, err := utils.GetExportSessionUrl(port, session.ID, docx.Format)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating export URL: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("%s exported successfully (%d bytes), and please return the following URL in the response:\n", docx.FileName(data), len(file))),
+				mcp.NewTextContent(fmt.Sprintf("Download DOCX: %s", downloadURL)),
+				mcp.NewEmbeddedResource(mcp.BlobResourceContents{
+					URI:      fmt.Sprintf("export://%s/%s", session.ID, docx.FileName(data)),
+					MIMEType: docx.ContentType,
+					Blob:     base64.StdEncoding.EncodeToString(file),
+				}),
+			},
+		}, nil
+	}
+
+	return tool, handler
+}
+
+// docxStylesArgument parses the styles argument and returns it with its JSON, which is empty for the default styles
+func docxStylesArgument(request mcp.CallToolRequest) (docx.Styles, []byte, error) {
+	data, ok, err := jsonArgument(request, "styles")
+	if err != nil || !ok {
+		return docx.DefaultStyles(), nil, err
+	}
+	styles, err := docx.ParseStyles(data)
+	return styles, data, err
+}
@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/docx"
+)
+
+// docxDocument returns the document.xml of the DOCX resource in the result
+func docxDocument(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+	if len(result.Content) != 3 {
+		t.Fatalf("Expected text, download URL and resource content, got %d items", len(result.Content))
+	}
+	downloadText := result.Content[1].(mcp.TextContent).Text
+	if !strings.HasPrefix(downloadText, "Download DOCX: http://localhost:8080/resume/export/") || !strings.HasSuffix(downloadText, "?format=docx") {
+		t.Errorf("Expected a DOCX download URL, got: %s", downloadText)
+	}
+	blob, ok := result.Content[2].(mcp.EmbeddedResource).Resource.(mcp.BlobResourceContents)
+	if !ok {
+		t.Fatalf("Expected a blob resource, got %T", result.Content[2].(mcp.EmbeddedResource).Resource)
+	}
+	if blob.MIMEType != docx.ContentType {
+		t.Errorf("Expected MIME type %s, got %s", docx.ContentType, blob.MIMEType)
+	}
+	if !strings.HasPrefix(blob.URI, "export://") || !strings.HasSuffix(blob.URI, ".docx") {
+		t.Errorf("Expected an export:// URI of a .docx file, got %s", blob.URI)
+	}
+
+	file, err := base64.StdEncoding.DecodeString(blob.Blob)
+	if err != nil {
+		t.Fatalf("Failed to decode docx: %v", err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		t.Fatalf("Failed to open docx: %v", err)
+	}
+	for _, f := range archive.File {
+		if f.Name == "word/document.xml" {
+			r, err := f.Open()
+			if err != nil {
+				t.Fatalf("Failed to open document.xml: %v", err)
+			}
+			defer r.Close()
+			content, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("Failed to read document.xml: %v", err)
+			}
+			return string(content)
+		}
+	}
+	t.Fatal("docx has no word/document.xml")
+	return ""
+}
+
+func TestExportDocxTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createFullTestResume(t, db)
+	createTestCoverLetter(t, db, resume.ID)
+
+	tool, handler := NewExportDocxTool(db, "8080")
+	if tool.Name != "export_docx" {
+		t.Errorf("Expected tool name 'export_docx', got %s", tool.Name)
+	}
+
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		contains []string
+	}{
+		{
+			name:     "resume",
+			args:     map[string]interface{}{"resume_id": "1"},
+			contains: []string{">Test User<", ">Software Engineer, Tech Corp<", "test@example.com · +1234567890"},
+		},
+		{
+			name:     "cover letter",
+			args:     map[string]interface{}{"resume_id": "1", "cover_letter_id": "1"},
+			contains: []string{">Dear Jane Smith,<", ">I built many things.<"},
+		},
+		{
+			name:     "styles",
+			args:     map[string]interface{}{"resume_id": "1", "styles": map[string]interface{}{"page_size": "a4", "date_position": "below"}},
+			contains: []string{`<w:pgSz w:w="11906" w:h="16838"/>`, `<w:pStyle w:val="Date"/>`},
+		},
+		{
+			name:     "styles as json",
+			args:     map[string]interface{}{"resume_id": "1", "styles": `{"page_size": "a4"}`},
+			contains: []string{`<w:pgSz w:w="11906" w:h="16838"/>`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			document := docxDocument(t, callTool(t, handler, test.args))
+			for _, expected := range test.contains {
+				if !strings.Contains(document, expected) {
+					t.Errorf("Expected document to contain %q, got:\n%s", expected, document)
+				}
+			}
+		})
+	}
+
+	for _, args := range []map[string]interface{}{
+		{"resume_id": "99"},
+		{"resume_id": "1", "cover_letter_id": "99"},
+		{"resume_id": "1", "styles": map[string]interface{}{"page_size": "legal"}},
+		{"resume_id": "1", "styles": map[string]interface{}{"unknown": true}},
+	} {
+		result, err := handler(createTestContext(), createTestRequest(args))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected error for %v", args)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/latex"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+	"github.com/rxtech-lab/resume-mcp/internal/utils"
+)
+
+func NewExportLaTeXTool(db *database.Database, port string, templateService *service.TemplateService) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("export_latex",
+		mcp.WithDescription(`Render a resume or a cover letter with a LaTeX template (created with format latex) and return the .tex source with download URLs for the source and the compiled PDF. With compile, the source is compiled with the tectonic or pdflatex installed on the server and the PDF is returned inline; if neither is installed the tool says so and the .tex source can be compiled elsewhere.
+
+Every value a LaTeX template prints is escaped, markdown converts Markdown to LaTeX and raw prints a value holding LaTeX as it is. Links from markdown need \usepackage{hyperref}.`),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("ID of the resume to export"),
+		),
+		mcp.WithString("template_id",
+			mcp.Description("ID of the LaTeX template to render, a template of the resume or a user template"),
+		),
+		mcp.WithString("template_data",
+			mcp.Description("LaTeX template to render instead of a stored template"),
+		),
+		mcp.WithString("cover_letter_id",
+			mcp.Description("ID of a cover letter of the resume to export instead of the resume, the template must be a cover_letter template (optional)"),
+		),
+		mcp.WithBoolean("compile",
+			mcp.Description("Compile the source and return the PDF as an embedded resource (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		templateService, err := userTemplateService(db, templateService, userID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		resume, data, result, err := exportData(db, request, userID)
+		if result != nil || err != nil {
+			return result, err
+		}
+
+		session := &models.PreviewSession{
+			ID:       uuid.New().String(),
+			ResumeID: resume.ID,
+			Format:   models.TemplateFormatLaTeX,
+		}
+		templateType := models.TemplateTypeResume
+		if letter, ok := data.(models.CoverLetter); ok {
+			templateType = models.TemplateTypeCoverLetter
+			session.CoverLetterID = &letter.ID
+		}
+
+		templateData := request.GetString("template_data", "")
+		if request.GetString("template_id", "") != "" {
+			if templateData != "" {
+				return mcp.NewToolResultError("Pass either template_id or template_data, not both"), nil
+			}
+			template, errResult, err := templateArgument(db, request, userID)
+			if errResult != nil || err != nil {
+				return errResult, err
+			}
+			if !template.UsableWith(resume.ID) {
+				return mcp.NewToolResultError("Template does not belong to the specified resume"), nil
+			}
+			if !template.IsLaTeX() {
+				return mcp.NewToolResultError("Template is not a LaTeX template, create one with format latex"), nil
+			}
+			if template.Type != templateType {
+				return mcp.NewToolResultError(fmt.Sprintf("Template is a %s template but a %s is exported", templateModelName(template.Type), templateModelName(templateType))), nil
+			}
+			templateData = template.TemplateData
+			session.TemplateID = &template.ID
+		} else if templateData == "" {
+			return mcp.NewToolResultError("template_id or template_data is required"), nil
+		}
+		session.Template = templateData
+
+		source, err := templateService.GenerateLaTeX(templateData, data)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error rendering LaTeX: %v", err)), nil
+		}
+
+		if err := db.CreatePreviewSession(session, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error exporting LaTeX: %v", err)), nil
+		}
+
+		sourceURL, err := utils.GetExportSessionUrl(port, session.ID, models.TemplateFormatLaTeX)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating export URL: %v", err)), nil
+		}
+
+		downloadURL, err := utils.GetDownloadSessionUrl(port, session.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating download URL: %v", err)), nil
+		}
+
+		pdfLine := fmt.Sprintf("Download PDF: %s\n", downloadURL)
+		if _, err := latex.Compiler(); err != nil {
+			pdfLine = fmt.Sprintf("PDF not available: %v\n", err)
+		}
+
+		content := []mcp.Content{
+			mcp.NewTextContent("LaTeX exported successfully, and please return the following URLs in the response:\n"),
+			mcp.NewTextContent(fmt.Sprintf("Download LaTeX: %s\n", sourceURL)),
+			mcp.NewTextContent(pdfLine),
+			mcp.NewTextContent(source),
+		}
+
+		if request.GetBool("compile", false) {
+			pdf, err := latex.Compile(ctx, source, service.DefaultPDFTimeout)
+			if errors.Is(err, latex.ErrNoCompiler) {
+				return mcp.NewToolResultError(fmt.Sprintf("Cannot compile: %v. The source is available at %s", err, sourceURL)), nil
+			}
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error compiling LaTeX: %v", err)), nil
+			}
+			content = append(content, newPDFResource(resume.ID, pdf))
+		}
+
+		return &mcp.CallToolResult{
+			Content: content,
+		}, nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+const testLaTeXTemplate = `\documentclass{article}
+\begin{document}
+{{.Name}}
+{{range .WorkExperiences}}\textbf{ {{.JobTitle}} } at {{.Company}}
+{{end}}\end{document}`
+
+func TestExportLaTeXTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	t.Setenv("PATH", t.TempDir())
+
+	templateService := service.NewTemplateService()
+	resume := createFullTestResume(t, db)
+	createTestTemplate(t, db, resume.ID)
+	if err := db.AddWorkExperience(&models.WorkExperience{ResumeID: resume.ID, Company: "R&D #1", JobTitle: "Intern", StartDate: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)}, &testUserID); err != nil {
+		t.Fatalf("Failed to add work experience: %v", err)
+	}
+
+	_, createHandler := NewCreateTemplateTool(db, templateService)
+	callTool(t, createHandler, map[string]interface{}{
+		"resume_id":     "1",
+		"name":          "LaTeX",
+		"template_data": testLaTeXTemplate,
+		"format":        "latex",
+	})
+
+	tool, handler := NewExportLaTeXTool(db, "8080", templateService)
+	if tool.Name != "export_latex" {
+		t.Errorf("Expected tool name 'export_latex', got %s", tool.Name)
+	}
+
+	result := callTool(t, handler, map[string]interface{}{"resume_id": "1", "template_id": "2"})
+	var text strings.Builder
+	for _, content := range result.Content {
+		text.WriteString(content.(mcp.TextContent).Text)
+	}
+	for _, expected := range []string{
+		"Download LaTeX: http://localhost:8080/resume/export/",
+		"?format=latex",
+		"PDF not available: no LaTeX compiler found",
+		`\textbf{ Software Engineer } at Tech Corp`,
+		`\textbf{ Intern } at R\&D \#1`,
+	} {
+		if !strings.Contains(text.String(), expected) {
+			t.Errorf("Expected result to contain %q, got:\n%s", expected, text.String())
+		}
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{"resume_id": "1", "template_id": "2", "compile": true}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "install tectonic or pdflatex") {
+		t.Errorf("Expected an error naming the missing compilers, got %+v", result.Content)
+	}
+
+	for _, args := range []map[string]interface{}{
+		{"resume_id": "1", "template_id": "1"},
+		{"resume_id": "1", "template_id": "2", "template_data": testLaTeXTemplate},
+		{"resume_id": "1"},
+		{"resume_id": "1", "template_data": "{{.Missing}}"},
+		{"resume_id": "99", "template_id": "2"},
+	} {
+		result, err := handler(createTestContext(), createTestRequest(args))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected error for %v", args)
+		}
+	}
+
+	_, previewHandler := NewGeneratePreviewTool(db, "8080", templateService)
+	result, err = previewHandler(createTestContext(), createTestRequest(map[string]interface{}{"resume_id": "1", "template_id": "2"}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "export_latex") {
+		t.Errorf("Expected generate_preview to refer LaTeX templates to export_latex, got %+v", result.Content)
+	}
+}
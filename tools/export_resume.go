@@ -0,0 +1,116 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewExportResumeTool(db *database.Database, templateService *service.TemplateService) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("export_resume",
+		mcp.WithDescription(`Export a resume or a cover letter as Markdown or as ATS friendly plain text, for job portals that want text pasted into a form or a .md file instead of a PDF. Returns the exported text.
+
+The built-in layouts are used unless template_data is given. Text templates use Go template syntax like HTML templates, but values aren't HTML escaped and markdown prints Markdown as it is for the markdown format and as plain text for the text format:
+# {{.Name}}
+{{range .WorkExperiences}}
+## {{.JobTitle}}, {{.Company}} ({{dateRange . "Jan 2006"}})
+{{range .FeatureMaps}}- {{indent 2 .Value}}
+{{end}}{{end}}`),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("ID of the resume to export"),
+		),
+		mcp.WithString("format",
+			mcp.Required(),
+			mcp.Description("Export format: markdown or text"),
+			mcp.Enum(service.TextFormatNames()...),
+		),
+		mcp.WithString("cover_letter_id",
+			mcp.Description("ID of a cover letter of the resume to export instead of the resume (optional)"),
+		),
+		mcp.WithString("template_data",
+			mcp.Description("Go text template to render instead of the built-in layout (optional)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		templateService, err := userTemplateService(db, templateService, userID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		format, err := request.RequireString("format")
+		if err != nil {
+			return nil, fmt.Errorf("format parameter is required: %w", err)
+		}
+		if _, err := service.LookupTextFormat(format); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid format: %v", err)), nil
+		}
+
+		_, data, result, err := exportData(db, request, userID)
+		if result != nil || err != nil {
+			return result, err
+		}
+
+		var text string
+		if templateData := request.GetString("template_data", ""); templateData != "" {
+			text, err = templateService.GenerateText(templateData, format, data)
+		} else {
+			text, err = templateService.ExportText(format, data)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error exporting %s: %v", format, err)), nil
+		}
+
+		return mcp.NewToolResultText(text), nil
+	}
+
+	return tool, handler
+}
+
+// exportData loads the resume of the resume_id argument, or its cover letter when cover_letter_id is given,
+// and returns the resume with the data to export. A non-nil result is the error to return to the client.
+func exportData(db *database.Database, request mcp.CallToolRequest, userID *string) (*models.Resume, any, *mcp.CallToolResult, error) {
+	resumeIDStr, err := request.RequireString("resume_id")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("resume_id parameter is required: %w", err)
+	}
+
+	resumeID, err := strconv.ParseUint(resumeIDStr, 10, 32)
+	if err != nil {
+		return nil, nil, mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id: %v", err)), nil
+	}
+
+	resume, err := db.GetResumeByID(uint(resumeID), userID)
+	if err != nil {
+		return nil, nil, mcp.NewToolResultError(fmt.Sprintf("Resume not found: %v", err)), nil
+	}
+
+	letterIDStr := request.GetString("cover_letter_id", "")
+	if letterIDStr == "" {
+		return resume, *resume, nil, nil
+	}
+
+	letterID, err := strconv.ParseUint(letterIDStr, 10, 32)
+	if err != nil {
+		return nil, nil, mcp.NewToolResultError(fmt.Sprintf("Invalid cover_letter_id: %v", err)), nil
+	}
+	letter, err := db.GetCoverLetterByID(uint(letterID), userID)
+	if err != nil {
+		return nil, nil, mcp.NewToolResultError(fmt.Sprintf("Cover letter not found: %v", err)), nil
+	}
+	if letter.ResumeID != resume.ID {
+		return nil, nil, mcp.NewToolResultError("Cover letter does not belong to the specified resume"), nil
+	}
+	return resume, *letter, nil, nil
+}
@@ -0,0 +1,76 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+func TestExportResumeTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createFullTestResume(t, db)
+	letter := createTestCoverLetter(t, db, resume.ID)
+
+	tool, handler := NewExportResumeTool(db, service.NewTemplateService())
+	if tool.Name != "export_resume" {
+		t.Errorf("Expected tool name 'export_resume', got %s", tool.Name)
+	}
+
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		contains []string
+	}{
+		{
+			name:     "markdown",
+			args:     map[string]interface{}{"resume_id": "1", "format": "markdown"},
+			contains: []string{"# Test User\n", "### Software Engineer, Tech Corp\n", "test@example.com · +1234567890"},
+		},
+		{
+			name:     "plain text",
+			args:     map[string]interface{}{"resume_id": "1", "format": "text"},
+			contains: []string{"TEST USER\n", "EXPERIENCE\n\nSoftware Engineer, Tech Corp\n"},
+		},
+		{
+			name:     "cover letter",
+			args:     map[string]interface{}{"resume_id": "1", "format": "text", "cover_letter_id": "1"},
+			contains: []string{"Dear " + letter.Recipient + ",", "EXPERIENCE\nI built many things."},
+		},
+		{
+			name:     "custom template",
+			args:     map[string]interface{}{"resume_id": "1", "format": "markdown", "template_data": "{{range .WorkExperiences}}* {{.Company}} & co{{end}}"},
+			contains: []string{"* Tech Corp & co"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := callTool(t, handler, test.args)
+			text := result.Content[0].(mcp.TextContent).Text
+			for _, expected := range test.contains {
+				if !strings.Contains(text, expected) {
+					t.Errorf("Expected export to contain %q, got:\n%s", expected, text)
+				}
+			}
+		})
+	}
+
+	for _, args := range []map[string]interface{}{
+		{"resume_id": "1", "format": "pdf"},
+		{"resume_id": "99", "format": "markdown"},
+		{"resume_id": "1", "format": "markdown", "cover_letter_id": "99"},
+		{"resume_id": "1", "format": "markdown", "template_data": "{{.Missing}}"},
+	} {
+		result, err := handler(createTestContext(), createTestRequest(args))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected error for %v", args)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/gallery"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewListGalleryTemplatesTool() (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_gallery_templates",
+		mcp.WithDescription("List the built-in professional templates that can be installed into a resume with install_gallery_template. Returns the name, title, description, type and tags of each template, followed by an SVG thumbnail of every layout as an embedded resource."),
+		mcp.WithString("type",
+			mcp.Description("Only list templates of this type: resume or cover_letter (default: all)"),
+			mcp.Enum(models.TemplateTypeResume, models.TemplateTypeCoverLetter),
+		),
+		mcp.WithBoolean("include_thumbnails",
+			mcp.Description("Return the SVG thumbnail of each template as an embedded resource (default: true)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		templateType := request.GetString("type", "")
+		includeThumbnails := request.GetBool("include_thumbnails", true)
+
+		templates, err := gallery.List()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list gallery templates: %v", err)), nil
+		}
+
+		listed := []gallery.Template{}
+		for _, template := range templates {
+			if templateType == "" || template.Type == templateType {
+				listed = append(listed, template)
+			}
+		}
+
+		result := map[string]interface{}{
+			"templates": listed,
+			"count":     len(listed),
+		}
+		resultJSON, _ := json.Marshal(result)
+
+		content := []mcp.Content{mcp.NewTextContent(string(resultJSON))}
+		if includeThumbnails {
+			for _, template := range listed {
+				// SVG isn't accepted as image content by many clients, so it is sent as a resource
+				content = append(content,
+					mcp.NewTextContent(fmt.Sprintf("Thumbnail of %s (%s):", template.Name, template.Title)),
+					mcp.NewEmbeddedResource(mcp.BlobResourceContents{
+						URI:      fmt.Sprintf("gallery://%s/thumbnail.svg", template.Name),
+						MIMEType: gallery.ThumbnailMIMEType,
+						Blob:     base64.StdEncoding.EncodeToString(template.Thumbnail),
+					}),
+				)
+			}
+		}
+
+		return &mcp.CallToolResult{
+			Content: content,
+		}, nil
+	}
+
+	return tool, handler
+}
+
+func NewInstallGalleryTemplateTool(db *database.Database, templateService *service.TemplateService) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("install_gallery_template",
+		mcp.WithDescription("Install a built-in template from list_gallery_templates into a resume. The installed template is a regular template that can be previewed, rendered and changed with update_template."),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("ID of the resume to install the template into"),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the gallery template, e.g. classic"),
+		),
+		mcp.WithString("template_name",
+			mcp.Description("Name of the installed template (default: the gallery template's title)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		resumeIDStr, err := request.RequireString("resume_id")
+		if err != nil {
+			return nil, fmt.Errorf("resume_id parameter is required: %w", err)
+		}
+
+		resumeID, err := strconv.ParseUint(resumeIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id: %v", err)), nil
+		}
+
+		name, err := request.RequireString("name")
+		if err != nil {
+			return nil, fmt.Errorf("name parameter is required: %w", err)
+		}
+
+		galleryTemplate, err := gallery.Get(name)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("%v, use list_gallery_templates to see the available templates", err)), nil
+		}
+
+		resume, err := db.GetResumeByID(uint(resumeID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Resume not found: %v", err)), nil
+		}
+
+		_, err = templateService.GeneratePreview(galleryTemplate.TemplateData, "", templateSampleData(galleryTemplate.Type, *resume))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Template validation failed: %v", err)), nil
+		}
+
+		template := &models.Template{
+			ResumeID:     &resume.ID,
+			Name:         request.GetString("template_name", galleryTemplate.Title),
+			Description:  galleryTemplate.Description,
+			Type:         galleryTemplate.Type,
+			TemplateData: galleryTemplate.TemplateData,
+		}
+
+		if err := db.CreateTemplate(template, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create template: %v", err)), nil
+		}
+
+		result := map[string]interface{}{
+			"template_id": template.ID,
+			"resume_id":   template.ResumeID,
+			"name":        template.Name,
+			"type":        template.Type,
+		}
+		resultJSON, _ := json.Marshal(result)
+		return mcp.NewToolResultText(fmt.Sprintf("Installed gallery template %s successfully: %s", galleryTemplate.Name, string(resultJSON))), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,161 @@
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/gallery"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+func TestListGalleryTemplatesTool(t *testing.T) {
+	tool, handler := NewListGalleryTemplatesTool()
+	if tool.Name != "list_gallery_templates" {
+		t.Errorf("Expected tool name 'list_gallery_templates', got %s", tool.Name)
+	}
+
+	all, err := gallery.List()
+	if err != nil {
+		t.Fatalf("Failed to list gallery: %v", err)
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	var listed struct {
+		Templates []gallery.Template `json:"templates"`
+		Count     int                `json:"count"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &listed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if listed.Count != len(all) {
+		t.Errorf("Expected %d templates, got %d", len(all), listed.Count)
+	}
+
+	var thumbnails int
+	for _, content := range result.Content {
+		if _, ok := content.(mcp.ImageContent); ok {
+			t.Error("Expected no image content, SVG images are rejected by many clients")
+		}
+		if resource, ok := content.(mcp.EmbeddedResource); ok {
+			thumbnails++
+			blob, ok := resource.Resource.(mcp.BlobResourceContents)
+			if !ok || blob.MIMEType != gallery.ThumbnailMIMEType || !strings.HasSuffix(blob.URI, "/thumbnail.svg") {
+				t.Errorf("Unexpected thumbnail resource: %+v", resource.Resource)
+			}
+		}
+	}
+	if thumbnails != len(all) {
+		t.Errorf("Expected %d thumbnails, got %d", len(all), thumbnails)
+	}
+
+	result, err = handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"type":               models.TemplateTypeCoverLetter,
+		"include_thumbnails": false,
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if len(result.Content) != 1 {
+		t.Errorf("Expected no thumbnails, got %d contents", len(result.Content))
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &listed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	for _, template := range listed.Templates {
+		if template.Type != models.TemplateTypeCoverLetter {
+			t.Errorf("Expected only cover letter templates, got %s (%s)", template.Name, template.Type)
+		}
+	}
+}
+
+func TestInstallGalleryTemplateTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	resume := createTestResume(t, db)
+
+	tool, handler := NewInstallGalleryTemplateTool(db, service.NewTemplateService())
+	if tool.Name != "install_gallery_template" {
+		t.Errorf("Expected tool name 'install_gallery_template', got %s", tool.Name)
+	}
+
+	templates, err := gallery.List()
+	if err != nil {
+		t.Fatalf("Failed to list gallery: %v", err)
+	}
+	for _, galleryTemplate := range templates {
+		result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+			"resume_id": "1",
+			"name":      galleryTemplate.Name,
+		}))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Failed to install %s: %v", galleryTemplate.Name, result.Content[0].(mcp.TextContent).Text)
+		}
+	}
+
+	installed, err := db.ListTemplatesByResumeID(resume.ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to list templates: %v", err)
+	}
+	if len(installed) != len(templates) {
+		t.Fatalf("Expected %d installed templates, got %d", len(templates), len(installed))
+	}
+
+	template, err := db.GetTemplateByID(installed[0].ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if template.Name != templates[0].Title || template.Type != templates[0].Type || template.TemplateData != templates[0].TemplateData {
+		t.Errorf("Installed template doesn't match gallery template %s: %+v", templates[0].Name, template)
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id":     "1",
+		"name":          "classic",
+		"template_name": "My Classic",
+	}))
+	if err != nil || result.IsError {
+		t.Fatalf("Failed to install with custom name: %v %v", err, result)
+	}
+	if !strings.Contains(result.Content[0].(mcp.TextContent).Text, `"name":"My Classic"`) {
+		t.Errorf("Expected custom template name in result, got %s", result.Content[0].(mcp.TextContent).Text)
+	}
+}
+
+func TestInstallGalleryTemplateTool_Errors(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	createTestResume(t, db)
+
+	_, handler := NewInstallGalleryTemplateTool(db, service.NewTemplateService())
+
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		expected string
+	}{
+		{"unknown template", map[string]interface{}{"resume_id": "1", "name": "missing"}, "not found in gallery"},
+		{"unknown resume", map[string]interface{}{"resume_id": "99", "name": "classic"}, "Resume not found"},
+		{"invalid resume id", map[string]interface{}{"resume_id": "abc", "name": "classic"}, "Invalid resume_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler(createTestContext(), createTestRequest(tt.args))
+			if err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+			if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, tt.expected) {
+				t.Errorf("Expected error containing %q, got %v", tt.expected, result.Content[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+	"github.com/rxtech-lab/resume-mcp/internal/utils"
+)
+
+// packetDocumentsSchema describes the items of the documents argument of generate_application_packet
+var packetDocumentsSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"resume_id": map[string]any{
+			"type":        "string",
+			"description": "ID of the resume to render, required unless cover_letter_id is given",
+		},
+		"cover_letter_id": map[string]any{
+			"type":        "string",
+			"description": "ID of the cover letter to render instead of a resume",
+		},
+		"template_id": map[string]any{
+			"type":        "string",
+			"description": "ID of the template to render the document with, a cover_letter template for cover letters",
+		},
+		"title": map[string]any{
+			"type":        "string",
+			"description": "Bookmark title of the document (default: the template name)",
+		},
+		"css": map[string]any{
+			"type":        "string",
+			"description": "Additional CSS styles for this document",
+		},
+	},
+	"required": []string{"template_id"},
+}
+
+// packetDocumentArgument is a document as given in the documents argument. IDs are accepted as strings or numbers.
+type packetDocumentArgument struct {
+	ResumeID      any    `json:"resume_id"`
+	CoverLetterID any    `json:"cover_letter_id"`
+	TemplateID    any    `json:"template_id"`
+	Title         string `json:"title"`
+	CSS           string `json:"css"`
+}
+
+func NewGenerateApplicationPacketTool(db *database.Database, port string, templateService *service.TemplateService) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("generate_application_packet",
+		mcp.WithDescription("Combine several documents, e.g. a resume, a cover letter and portfolio pages, into a single PDF with a bookmark for each document. Every document is rendered with its own template, in the given order. Returns a download URL and optionally the merged PDF inline."),
+		mcp.WithArray("documents",
+			mcp.Required(),
+			mcp.Description("Documents of the packet in order. Each document renders a resume or a cover letter with a template of the same resume or a user template."),
+			mcp.Items(packetDocumentsSchema),
+		),
+		mcp.WithBoolean("include_pdf",
+			mcp.Description("Return the merged PDF as an embedded resource (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		templateService, err := userTemplateService(db, templateService, userID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		arguments, err := packetDocumentsArgument(request)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		includePDF := request.GetBool("include_pdf", false)
+
+		var documents []models.PacketDocument
+		var rendered []service.PacketDocument
+		for i, argument := range arguments {
+			document, data, err := resolvePacketDocument(db, argument, userID)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Document %d: %v", i+1, err)), nil
+			}
+
+			if _, err := templateService.GeneratePreview(document.Template, document.CSS, data); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Document %d: error rendering %q: %v", i+1, document.Title, err)), nil
+			}
+
+			documents = append(documents, *document)
+			rendered = append(rendered, service.PacketDocument{
+				Title:    document.Title,
+				Template: document.Template,
+				CSS:      document.CSS,
+				Data:     data,
+			})
+		}
+
+		packetID, err := db.CreateApplicationPacket(documents, userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating application packet: %v", err)), nil
+		}
+
+		downloadURL, err := utils.GetPacketDownloadUrl(port, packetID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating download URL: %v", err)), nil
+		}
+
+		var titles []string
+		for _, document := range documents {
+			titles = append(titles, document.Title)
+		}
+
+		content := []mcp.Content{
+			mcp.NewTextContent("Application packet generated successfully, and please return the following URL in the response:\n"),
+			mcp.NewTextContent(fmt.Sprintf("Download PDF: %s\n", downloadURL)),
+			mcp.NewTextContent(fmt.Sprintf("Documents: %s", strings.Join(titles, ", "))),
+		}
+
+		if includePDF {
+			pdf, err := templateService.GeneratePacketPDF(ctx, rendered, service.DefaultPDFTimeout)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error rendering PDF: %v", err)), nil
+			}
+			content = append(content, mcp.NewEmbeddedResource(mcp.BlobResourceContents{
+				URI:      fmt.Sprintf("packet://%s/pdf", packetID),
+				MIMEType: "application/pdf",
+				Blob:     base64.StdEncoding.EncodeToString(pdf),
+			}))
+		}
+
+		return &mcp.CallToolResult{
+			Content: content,
+		}, nil
+	}
+
+	return tool, handler
+}
+
+// packetDocumentsArgument parses the documents argument, given either as an array or as a JSON string
+func packetDocumentsArgument(request mcp.CallToolRequest) ([]packetDocumentArgument, error) {
+	raw, ok, err := jsonArgument(request, "documents")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("documents parameter is required")
+	}
+
+	var documents []packetDocumentArgument
+	if err := json.Unmarshal(raw, &documents); err != nil {
+		return nil, fmt.Errorf("documents must be an array of objects with template_id and resume_id or cover_letter_id: %w", err)
+	}
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("documents must contain at least one document")
+	}
+	return documents, nil
+}
+
+// resolvePacketDocument checks the document's template against its resume or cover letter and
+// returns the document to store together with the data its template renders
+func resolvePacketDocument(db *database.Database, argument packetDocumentArgument, userID *string) (*models.PacketDocument, any, error) {
+	templateID, err := packetID(argument.TemplateID, "template_id")
+	if err != nil {
+		return nil, nil, err
+	}
+	template, err := db.GetTemplateByID(templateID, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting template: %w", err)
+	}
+	if template.IsLaTeX() {
+		return nil, nil, fmt.Errorf("template %d is a LaTeX template, packets merge HTML templates", template.ID)
+	}
+
+	document := &models.PacketDocument{
+		Title:    strings.TrimSpace(argument.Title),
+		Template: template.TemplateData,
+		CSS:      argument.CSS,
+	}
+	if document.Title == "" {
+		document.Title = template.Name
+	}
+
+	var data any
+	if argument.CoverLetterID != nil {
+		letterID, err := packetID(argument.CoverLetterID, "cover_letter_id")
+		if err != nil {
+			return nil, nil, err
+		}
+		letter, err := db.GetCoverLetterByID(letterID, userID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting cover letter: %w", err)
+		}
+		if template.Type != models.TemplateTypeCoverLetter {
+			return nil, nil, fmt.Errorf("template %d is not a cover letter template", template.ID)
+		}
+		document.ResumeID = letter.ResumeID
+		document.CoverLetterID = &letter.ID
+		data = *letter
+	} else {
+		if argument.ResumeID == nil {
+			return nil, nil, fmt.Errorf("resume_id or cover_letter_id is required")
+		}
+		resumeID, err := packetID(argument.ResumeID, "resume_id")
+		if err != nil {
+			return nil, nil, err
+		}
+		resume, err := db.GetResumeByID(resumeID, userID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting resume: %w", err)
+		}
+		if template.Type == models.TemplateTypeCoverLetter {
+			return nil, nil, fmt.Errorf("template %d is a cover letter template, use it with cover_letter_id", template.ID)
+		}
+		document.ResumeID = resume.ID
+		data = *resume
+	}
+
+	if !template.UsableWith(document.ResumeID) {
+		return nil, nil, fmt.Errorf("template %d does not belong to resume %d", template.ID, document.ResumeID)
+	}
+	return document, data, nil
+}
+
+// packetID parses an ID of the documents argument, given as a string or a number
+func packetID(value any, name string) (uint, error) {
+	var raw string
+	switch v := value.(type) {
+	case string:
+		raw = v
+	case float64:
+		raw = strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return 0, fmt.Errorf("%s is required", name)
+	default:
+		return 0, fmt.Errorf("invalid %s: %v", name, value)
+	}
+	id, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+func createTestCoverLetterTemplate(t *testing.T, db *database.Database, resumeID uint) *models.Template {
+	template := &models.Template{
+		ResumeID:     &resumeID,
+		Name:         "Letter",
+		Type:         models.TemplateTypeCoverLetter,
+		TemplateData: testCoverLetterTemplate,
+	}
+	if err := db.CreateTemplate(template, &testUserID); err != nil {
+		t.Fatalf("Failed to create cover letter template: %v", err)
+	}
+	return template
+}
+
+func TestGenerateApplicationPacketTool_Success(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	templateService := service.NewTemplateService()
+	resume := createTestResume(t, db)
+	letter := createTestCoverLetter(t, db, resume.ID)
+	resumeTemplate := createTestTemplate(t, db, resume.ID)
+	createTestCoverLetterTemplate(t, db, resume.ID)
+
+	tool, handler := NewGenerateApplicationPacketTool(db, "8080", templateService)
+	if tool.Name != "generate_application_packet" {
+		t.Errorf("Expected tool name 'generate_application_packet', got %s", tool.Name)
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"documents": []interface{}{
+			map[string]interface{}{"cover_letter_id": "1", "template_id": "2", "title": "Cover Letter"},
+			map[string]interface{}{"resume_id": float64(resume.ID), "template_id": "1", "css": "h1 { color: red; }"},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error: %v", result.Content[0].(mcp.TextContent).Text)
+	}
+
+	downloadText := result.Content[1].(mcp.TextContent).Text
+	expectedPrefix := "Download PDF: http://localhost:8080/resume/packet/"
+	if !strings.HasPrefix(downloadText, expectedPrefix) {
+		t.Fatalf("Expected download URL with prefix %q, got %q", expectedPrefix, downloadText)
+	}
+	packetID := strings.TrimPrefix(strings.TrimSpace(downloadText), expectedPrefix)
+
+	packet, err := db.GetApplicationPacket(packetID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get application packet: %v", err)
+	}
+	if len(packet.Documents) != 2 {
+		t.Fatalf("Expected 2 documents, got %d", len(packet.Documents))
+	}
+
+	first, second := packet.Documents[0], packet.Documents[1]
+	if first.Title != "Cover Letter" || first.CoverLetterID == nil || *first.CoverLetterID != letter.ID || first.Template != testCoverLetterTemplate {
+		t.Errorf("Unexpected first document: %+v", first)
+	}
+	if second.Title != resumeTemplate.Name || second.CoverLetterID != nil || second.ResumeID != resume.ID || second.CSS != "h1 { color: red; }" {
+		t.Errorf("Unexpected second document: %+v", second)
+	}
+
+	data, err := db.PacketDocumentData(&first, nil)
+	if err != nil {
+		t.Fatalf("Failed to get document data: %v", err)
+	}
+	if _, ok := data.(models.CoverLetter); !ok {
+		t.Errorf("Expected cover letter data for first document, got %T", data)
+	}
+}
+
+func TestGenerateApplicationPacketTool_Errors(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	templateService := service.NewTemplateService()
+	resume := createTestResume(t, db)
+	createTestCoverLetter(t, db, resume.ID)
+	createTestTemplate(t, db, resume.ID)
+	createTestCoverLetterTemplate(t, db, resume.ID)
+	otherResume := &models.Resume{Name: "Other"}
+	if err := db.CreateResume(otherResume, &testUserID); err != nil {
+		t.Fatalf("Failed to create resume: %v", err)
+	}
+
+	_, handler := NewGenerateApplicationPacketTool(db, "8080", templateService)
+
+	tests := []struct {
+		name      string
+		documents interface{}
+		expected  string
+	}{
+		{"missing documents", nil, "documents parameter is required"},
+		{"empty documents", []interface{}{}, "at least one document"},
+		{"invalid JSON", "not json", "documents must be an array"},
+		{"missing source", []interface{}{map[string]interface{}{"template_id": "1"}}, "resume_id or cover_letter_id is required"},
+		{"missing template", []interface{}{map[string]interface{}{"resume_id": "1"}}, "template_id is required"},
+		{"cover letter template for resume", []interface{}{map[string]interface{}{"resume_id": "1", "template_id": "2"}}, "is a cover letter template"},
+		{"resume template for cover letter", []interface{}{map[string]interface{}{"cover_letter_id": "1", "template_id": "1"}}, "is not a cover letter template"},
+		{"template of another resume", []interface{}{map[string]interface{}{"resume_id": "2", "template_id": "1"}}, "does not belong to resume 2"},
+		{"unknown resume", []interface{}{map[string]interface{}{"resume_id": "99", "template_id": "1"}}, "Document 1: error getting resume"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arguments := map[string]interface{}{}
+			if tt.documents != nil {
+				arguments["documents"] = tt.documents
+			}
+			result, err := handler(createTestContext(), createTestRequest(arguments))
+			if err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+			if !result.IsError {
+				t.Fatal("Expected error result")
+			}
+			if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, tt.expected) {
+				t.Errorf("Expected error containing %q, got %q", tt.expected, text)
+			}
+		})
+	}
+}
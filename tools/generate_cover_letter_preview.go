@@ -0,0 +1,148 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/docx"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+	"github.com/rxtech-lab/resume-mcp/internal/utils"
+)
+
+func NewGenerateCoverLetterPreviewTool(db *database.Database, port string, templateService *service.TemplateService) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("generate_cover_letter_preview",
+		mcp.WithDescription("Generate an HTML preview of a cover letter using a cover letter template of the same resume. Returns a preview URL and a PDF download URL, and optionally the rendered PDF inline."),
+		mcp.WithString("cover_letter_id",
+			mcp.Required(),
+			mcp.Description("The ID of the cover letter to preview"),
+		),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("The ID of a template created with type cover_letter"),
+		),
+		mcp.WithString("css",
+			mcp.Description("Additional CSS styles for the preview (optional, Tailwind CSS classes are available in templates)"),
+		),
+		mcp.WithBoolean("include_pdf",
+			mcp.Description("Return the rendered PDF as an embedded resource (default: false)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		templateService, err := userTemplateService(db, templateService, userID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		letterIDStr, err := request.RequireString("cover_letter_id")
+		if err != nil {
+			return nil, fmt.Errorf("cover_letter_id parameter is required: %w", err)
+		}
+
+		letterID, err := strconv.ParseUint(letterIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid cover_letter_id: %v", err)), nil
+		}
+
+		templateIDStr, err := request.RequireString("template_id")
+		if err != nil {
+			return nil, fmt.Errorf("template_id parameter is required: %w", err)
+		}
+
+		templateID, err := strconv.ParseUint(templateIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid template_id: %v", err)), nil
+		}
+
+		css := request.GetString("css", "")
+		includePDF := request.GetBool("include_pdf", false)
+
+		letter, err := db.GetCoverLetterByID(uint(letterID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting cover letter: %v", err)), nil
+		}
+
+		template, err := db.GetTemplateByID(uint(templateID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting template: %v", err)), nil
+		}
+
+		if template.Type != models.TemplateTypeCoverLetter {
+			return mcp.NewToolResultError("Template is not a cover letter template, create one with type cover_letter"), nil
+		}
+
+		if !template.UsableWith(letter.ResumeID) {
+			return mcp.NewToolResultError("Template does not belong to the cover letter's resume"), nil
+		}
+
+		if template.IsLaTeX() {
+			return mcp.NewToolResultError("Template is a LaTeX template, use export_latex instead"), nil
+		}
+
+		_, err = templateService.GeneratePreview(template.TemplateData, css, *letter)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating preview: %v", err)), nil
+		}
+
+		sessionID, err := db.GenerateCoverLetterPreview(letter, template.TemplateData, css, userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating preview: %v", err)), nil
+		}
+
+		previewURL, err := utils.GetTransactionSessionUrl(port, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating preview: %v", err)), nil
+		}
+
+		downloadURL, err := utils.GetDownloadSessionUrl(port, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating download URL: %v", err)), nil
+		}
+
+		exportURL, err := utils.GetExportSessionUrl(port, sessionID, service.FormatMarkdown)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating export URL: %v", err)), nil
+		}
+
+		docxURL, err := utils.GetExportSessionUrl(port, sessionID, docx.Format)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating export URL: %v", err)), nil
+		}
+
+		content := []mcp.Content{
+			mcp.NewTextContent("Cover letter preview generated successfully, and please return the following URLs in the response:\n"),
+			mcp.NewTextContent(fmt.Sprintf("Preview: %s\n", previewURL)),
+			mcp.NewTextContent(fmt.Sprintf("Download PDF: %s\n", downloadURL)),
+			mcp.NewTextContent(fmt.Sprintf("Download Markdown: %s (format=text for plain text)\n", exportURL)),
+			mcp.NewTextContent(fmt.Sprintf("Download DOCX: %s", docxURL)),
+		}
+
+		if includePDF {
+			pdf, err := templateService.GeneratePDFWithProgress(ctx, template.TemplateData, css, *letter, service.DefaultPDFTimeout, nil)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error rendering PDF: %v", err)), nil
+			}
+			content = append(content, mcp.NewEmbeddedResource(mcp.BlobResourceContents{
+				URI:      fmt.Sprintf("cover-letter://%d/pdf", letter.ID),
+				MIMEType: "application/pdf",
+				Blob:     base64.StdEncoding.EncodeToString(pdf),
+			}))
+		}
+
+		return &mcp.CallToolResult{
+			Content: content,
+		}, nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,131 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+const testCoverLetterTemplate = `<p>{{.Recipient}}, {{.Company}}</p>{{range .Sections}}<h2>{{.Heading}}</h2><p>{{.Body}}</p>{{end}}<p>{{.Resume.Name}}</p>`
+
+func TestGenerateCoverLetterPreviewTool_Success(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	templateService := service.NewTemplateService()
+	resume := createTestResume(t, db)
+	letter := createTestCoverLetter(t, db, resume.ID)
+
+	_, createTemplateHandler := NewCreateTemplateTool(db, templateService)
+	result, err := createTemplateHandler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id":     "1",
+		"name":          "Letter",
+		"type":          models.TemplateTypeCoverLetter,
+		"template_data": testCoverLetterTemplate,
+	}))
+	if err != nil || result.IsError {
+		t.Fatalf("Failed to create cover letter template: %v %v", err, result)
+	}
+
+	tool, handler := NewGenerateCoverLetterPreviewTool(db, "8080", templateService)
+	if tool.Name != "generate_cover_letter_preview" {
+		t.Errorf("Expected tool name 'generate_cover_letter_preview', got %s", tool.Name)
+	}
+
+	result, err = handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"cover_letter_id": "1",
+		"template_id":     "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	previewText := result.Content[1].(mcp.TextContent).Text
+	expectedPreviewPrefix := "Preview: http://localhost:8080/resume/preview/"
+	sessionID := strings.TrimPrefix(strings.TrimSpace(previewText), expectedPreviewPrefix)
+
+	session, err := db.GetPreviewSession(sessionID, nil)
+	if err != nil {
+		t.Fatalf("Failed to get preview session: %v", err)
+	}
+	if session.CoverLetterID == nil || *session.CoverLetterID != letter.ID || session.ResumeID != resume.ID {
+		t.Errorf("Expected session for cover letter %d, got %+v", letter.ID, session)
+	}
+
+	letter, err = db.GetCoverLetterByID(letter.ID, nil)
+	if err != nil {
+		t.Fatalf("Failed to get cover letter: %v", err)
+	}
+	html, err := templateService.GeneratePreview(session.Template, session.CSS, *letter)
+	if err != nil {
+		t.Fatalf("Failed to render letter: %v", err)
+	}
+	for _, expected := range []string{"Jane Smith, Acme Corp", "<h2>Experience</h2>", "Test User"} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("Expected rendered letter to contain %q", expected)
+		}
+	}
+}
+
+func TestGenerateCoverLetterPreviewTool_TemplateTypes(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	templateService := service.NewTemplateService()
+	resume := createTestResume(t, db)
+	createTestCoverLetter(t, db, resume.ID)
+	createTestTemplate(t, db, resume.ID)
+
+	_, handler := NewGenerateCoverLetterPreviewTool(db, "8080", templateService)
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"cover_letter_id": "1",
+		"template_id":     "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "not a cover letter template") {
+		t.Errorf("Expected template type error, got: %s", text)
+	}
+
+	// Resume previews reject cover letter templates
+	letterTemplate := &models.Template{ResumeID: &resume.ID, Name: "Letter", Type: models.TemplateTypeCoverLetter, TemplateData: testCoverLetterTemplate}
+	db.CreateTemplate(letterTemplate, &testUserID)
+
+	_, previewHandler := NewGeneratePreviewTool(db, "8080", templateService)
+	result, err = previewHandler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id":   "1",
+		"template_id": "2",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "use generate_cover_letter_preview") {
+		t.Errorf("Expected cover letter template error, got: %s", text)
+	}
+}
+
+func TestCreateTemplateTool_CoverLetterValidation(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	createTestResume(t, db)
+	_, handler := NewCreateTemplateTool(db, service.NewTemplateService())
+
+	// Resume fields don't exist on cover letters
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id":     "1",
+		"name":          "Letter",
+		"type":          models.TemplateTypeCoverLetter,
+		"template_data": "<h1>{{.WorkExperiences}}</h1>",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "fields exist on the cover letter model") {
+		t.Errorf("Expected cover letter validation error, got: %s", text)
+	}
+}
@@ -2,12 +2,16 @@ package tools
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strconv"
 
+	"github.com/google/uuid"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/docx"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
 	"github.com/rxtech-lab/resume-mcp/internal/service"
 	"github.com/rxtech-lab/resume-mcp/internal/types"
 	"github.com/rxtech-lab/resume-mcp/internal/utils"
@@ -15,24 +19,38 @@ import (
 
 func NewGeneratePreviewTool(db *database.Database, port string, templateService *service.TemplateService) (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("generate_preview",
-		mcp.WithDescription("Generate HTML preview of a resume using a saved template. Returns a preview URL. Templates include Tailwind CSS for styling."),
+		mcp.WithDescription("Generate HTML preview of a resume using a saved template. Returns a preview URL. Templates include Tailwind CSS for styling. Optionally returns the rendered PDF and PNG screenshots of each page inline, so the layout can be checked without opening the URLs."),
 		mcp.WithString("resume_id",
 			mcp.Required(),
 			mcp.Description("The ID of the resume to generate preview for"),
 		),
 		mcp.WithString("template_id",
 			mcp.Required(),
-			mcp.Description("The ID of the template to use for rendering"),
+			mcp.Description("The ID of the template to use for rendering, a template of the resume or a user template"),
 		),
 		mcp.WithString("css",
 			mcp.Description("Additional CSS styles for the preview (optional, Tailwind CSS classes are available in templates)"),
 		),
+		mcp.WithString("revision",
+			mcp.Description("Revision of the template to pin the preview to, as listed by list_template_revisions (optional). Without a revision the preview follows the latest template data."),
+		),
+		mcp.WithBoolean("include_pdf",
+			mcp.Description("Return the rendered PDF as an embedded resource (default: false)"),
+		),
+		mcp.WithBoolean("include_page_images",
+			mcp.Description("Return a PNG image of each printed page as image content (default: false). Pages use the PDF's paper size, margins and print styles, but page breaks are approximated: only forced breaks and break-inside: avoid are honored, and widows, orphans and table headers may break differently than in the PDF."),
+		),
 	)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		user := types.GetAuthenticatedUser(ctx)
 		userID := &user.Sub
 
+		templateService, err := userTemplateService(db, templateService, userID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		resumeIDStr, err := request.RequireString("resume_id")
 		if err != nil {
 			return nil, fmt.Errorf("resume_id parameter is required: %w", err)
@@ -54,6 +72,8 @@ func NewGeneratePreviewTool(db *database.Database, port string, templateService
 		}
 
 		css := request.GetString("css", "")
+		includePDF := request.GetBool("include_pdf", false)
+		includePageImages := request.GetBool("include_page_images", false)
 
 		resume, err := db.GetResumeByID(uint(resumeID), userID)
 		if err != nil {
@@ -65,20 +85,44 @@ func NewGeneratePreviewTool(db *database.Database, port string, templateService
 			return mcp.NewToolResultError(fmt.Sprintf("Error getting template: %v", err)), nil
 		}
 
-		// Verify template belongs to the same resume
-		if template.ResumeID != uint(resumeID) {
+		// Verify template belongs to the same resume or to the user
+		if !template.UsableWith(uint(resumeID)) {
 			return mcp.NewToolResultError("Template does not belong to the specified resume"), nil
 		}
 
-		_, err = templateService.GeneratePreview(template.TemplateData, css, *resume)
+		if template.Type == models.TemplateTypeCoverLetter {
+			return mcp.NewToolResultError("Template is a cover letter template, use generate_cover_letter_preview instead"), nil
+		}
+
+		if template.IsLaTeX() {
+			return mcp.NewToolResultError("Template is a LaTeX template, use export_latex instead"), nil
+		}
+
+		session := &models.PreviewSession{
+			ID:         uuid.New().String(),
+			ResumeID:   uint(resumeID),
+			Template:   template.TemplateData,
+			CSS:        css,
+			TemplateID: &template.ID,
+		}
+		if revisionStr := request.GetString("revision", ""); revisionStr != "" {
+			revision, errResult := templateRevisionArgument(db, template.ID, "revision", revisionStr, userID)
+			if errResult != nil {
+				return errResult, nil
+			}
+			session.Template = revision.TemplateData
+			session.TemplateRevision = &revision.Revision
+		}
+
+		_, err = templateService.GeneratePreview(session.Template, css, *resume)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Error generating preview: %v", err)), nil
 		}
 
-		sessionID, err := db.GeneratePreview(uint(resumeID), template.TemplateData, css, userID)
-		if err != nil {
+		if err := db.CreatePreviewSession(session, userID); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Error generating preview: %v", err)), nil
 		}
+		sessionID := session.ID
 
 		previewURL, err := utils.GetTransactionSessionUrl(port, sessionID)
 		if err != nil {
@@ -90,12 +134,50 @@ func NewGeneratePreviewTool(db *database.Database, port string, templateService
 			return mcp.NewToolResultError(fmt.Sprintf("Error generating download URL: %v", err)), nil
 		}
 
+		exportURL, err := utils.GetExportSessionUrl(port, sessionID, service.FormatMarkdown)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating export URL: %v", err)), nil
+		}
+
+		docxURL, err := utils.GetExportSessionUrl(port, sessionID, docx.Format)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error generating export URL: %v", err)), nil
+		}
+
+		content := []mcp.Content{
+			mcp.NewTextContent("Preview generated successfully, and please return the following URLs in the response:\n"),
+			mcp.NewTextContent(fmt.Sprintf("Preview: %s\n", previewURL)),
+			mcp.NewTextContent(fmt.Sprintf("Download PDF: %s\n", downloadURL)),
+			mcp.NewTextContent(fmt.Sprintf("Download Markdown: %s (format=text for plain text)\n", exportURL)),
+			mcp.NewTextContent(fmt.Sprintf("Download DOCX: %s", docxURL)),
+		}
+		if session.TemplateRevision != nil {
+			content = append(content, mcp.NewTextContent(fmt.Sprintf("\nPinned to revision %d of template %d", *session.TemplateRevision, template.ID)))
+		}
+
+		if includePDF {
+			pdf, err := templateService.GeneratePDFWithProgress(ctx, session.Template, css, *resume, service.DefaultPDFTimeout, nil)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error rendering PDF: %v", err)), nil
+			}
+			content = append(content, newPDFResource(uint(resumeID), pdf))
+		}
+
+		if includePageImages {
+			images, err := templateService.GeneratePageImages(ctx, session.Template, css, *resume, service.DefaultPDFTimeout)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error capturing page images: %v", err)), nil
+			}
+			for i, image := range images {
+				content = append(content,
+					mcp.NewTextContent(fmt.Sprintf("Page %d of %d:", i+1, len(images))),
+					mcp.NewImageContent(base64.StdEncoding.EncodeToString(image), "image/png"),
+				)
+			}
+		}
+
 		return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				mcp.NewTextContent("Preview generated successfully, and please return the following URLs in the response:\n"),
-				mcp.NewTextContent(fmt.Sprintf("Preview: %s\n", previewURL)),
-				mcp.NewTextContent(fmt.Sprintf("Download PDF: %s", downloadURL)),
-			},
+			Content: content,
 		}, nil
 	}
 
@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -344,3 +345,115 @@ func TestGeneratePreviewTool_InvalidIDs(t *testing.T) {
 		})
 	}
 }
+
+func TestGeneratePreviewTool_InlineOptions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	templateService := service.NewTemplateService()
+	tool, _ := NewGeneratePreviewTool(db, "8080", templateService)
+
+	for _, param := range []string{"include_pdf", "include_page_images"} {
+		if _, ok := tool.InputSchema.Properties[param]; !ok {
+			t.Errorf("Expected %s parameter", param)
+		}
+	}
+}
+
+func TestGeneratePreviewTool_InlineRenderingCancelled(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	templateService := service.NewTemplateService()
+	resume := createTestResume(t, db)
+	_ = createTestTemplate(t, db, resume.ID)
+
+	_, handler := NewGeneratePreviewTool(db, "8080", templateService)
+
+	tests := []struct {
+		name          string
+		param         string
+		expectedError string
+	}{
+		{name: "pdf", param: "include_pdf", expectedError: "Error rendering PDF"},
+		{name: "page images", param: "include_page_images", expectedError: "Error capturing page images"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(createTestContext())
+			cancel()
+
+			result, err := handler(ctx, createTestRequest(map[string]interface{}{
+				"resume_id":   "1",
+				"template_id": "1",
+				tt.param:      true,
+			}))
+			if err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("Expected TextContent, got %T", result.Content[0])
+			}
+
+			if !strings.Contains(textContent.Text, tt.expectedError) {
+				t.Errorf("Expected '%s' error, got: %s", tt.expectedError, textContent.Text)
+			}
+		})
+	}
+}
+
+func TestGeneratePreviewTool_PinnedRevision(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	template := createTestTemplate(t, db, resume.ID)
+	original := template.TemplateData
+
+	template.TemplateData = "<h2>{{.Name}}</h2>"
+	if err := db.UpdateTemplateWithNote(template, "Smaller heading", &testUserID); err != nil {
+		t.Fatalf("Failed to update template: %v", err)
+	}
+
+	_, handler := NewGeneratePreviewTool(db, "8080", service.NewTemplateService())
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id":   "1",
+		"template_id": "1",
+		"revision":    "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error: %v", result.Content[0].(mcp.TextContent).Text)
+	}
+
+	previewURL := strings.TrimSpace(result.Content[1].(mcp.TextContent).Text)
+	sessionID := previewURL[strings.LastIndex(previewURL, "/")+1:]
+	session, err := db.GetPreviewSession(sessionID, nil)
+	if err != nil {
+		t.Fatalf("Failed to get preview session: %v", err)
+	}
+	if session.Template != original {
+		t.Errorf("Expected session to render revision 1, got %q", session.Template)
+	}
+	if session.TemplateID == nil || *session.TemplateID != template.ID || session.TemplateRevision == nil || *session.TemplateRevision != 1 {
+		t.Errorf("Expected session pinned to revision 1 of template %d, got %v/%v", template.ID, session.TemplateID, session.TemplateRevision)
+	}
+
+	result, err = handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id":   "1",
+		"template_id": "1",
+		"revision":    "5",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected error for a missing revision")
+	}
+}
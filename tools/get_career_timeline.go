@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/analysis"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewGetCareerTimelineTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_career_timeline",
+		mcp.WithDescription(`Compute the career timeline and experience statistics of a resume.
+
+Use this instead of adding up dates yourself when writing summaries such as "8 years of experience".
+The result contains:
+- entries: work experiences and educations in chronological order
+- total_years: professional experience with overlapping work experiences counted once
+- years_by_type: years per employment type (fulltime, parttime, internship)
+- education_years: time spent in education, overlaps counted once
+- gaps: breaks of a month or more not covered by work or education
+- skills: years per skill, from work experience feature maps with the category or key "skills"
+
+Entries without an end date count as ongoing until today.
+Templates can use the same data with {{ with timeline . }}{{ .TotalYears }}{{ end }}.`),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("ID of the resume"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		resumeIDStr, err := request.RequireString("resume_id")
+		if err != nil {
+			return nil, fmt.Errorf("resume_id parameter is required: %w", err)
+		}
+
+		resumeID, err := strconv.ParseUint(resumeIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id: %v", err)), nil
+		}
+
+		resume, err := db.GetResumeByID(uint(resumeID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting resume: %v", err)), nil
+		}
+
+		timeline := analysis.BuildTimeline(resume, time.Now())
+
+		resultJSON, _ := json.Marshal(timeline)
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/analysis"
+)
+
+func TestGetCareerTimelineTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	createFullTestResume(t, db)
+
+	tool, handler := NewGetCareerTimelineTool(db)
+	if tool.Name != "get_career_timeline" {
+		t.Errorf("Expected tool name 'get_career_timeline', got %s", tool.Name)
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id": "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("Expected TextContent, got %T", result.Content[0])
+	}
+
+	var timeline analysis.Timeline
+	if err := json.Unmarshal([]byte(textContent.Text), &timeline); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+
+	// Tech Corp from 2020-01-01 to 2023-12-31
+	if timeline.TotalYears != 4 {
+		t.Errorf("Expected 4 total years, got %v", timeline.TotalYears)
+	}
+	if len(timeline.Entries) != 2 {
+		t.Errorf("Expected work experience and education entries, got %+v", timeline.Entries)
+	}
+	if len(timeline.Skills) == 0 || timeline.Skills[0].Years != 4 {
+		t.Errorf("Expected skills from the skills feature map, got %+v", timeline.Skills)
+	}
+}
+
+func TestGetCareerTimelineTool_ResumeNotFound(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, handler := NewGetCareerTimelineTool(db)
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id": "999",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	textContent := result.Content[0].(mcp.TextContent)
+	if !strings.Contains(textContent.Text, "Error getting resume") {
+		t.Errorf("Expected 'Error getting resume' error, got: %s", textContent.Text)
+	}
+}
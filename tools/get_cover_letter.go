@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewGetCoverLetterTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_cover_letter",
+		mcp.WithDescription("Get a cover letter with all its sections by ID"),
+		mcp.WithString("cover_letter_id",
+			mcp.Required(),
+			mcp.Description("ID of the cover letter to retrieve"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		letterIDStr, err := request.RequireString("cover_letter_id")
+		if err != nil {
+			return nil, fmt.Errorf("cover_letter_id parameter is required: %w", err)
+		}
+
+		letterID, err := strconv.ParseUint(letterIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid cover_letter_id: %v", err)), nil
+		}
+
+		letter, err := db.GetCoverLetterByID(uint(letterID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Cover letter not found: %v", err)), nil
+		}
+
+		result := map[string]any{
+			"cover_letter": letter,
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return mcp.NewToolResultText(fmt.Sprintf("Cover letter retrieved successfully: %s", string(resultJSON))), nil
+	}
+
+	return tool, handler
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/markdown"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
 	"github.com/rxtech-lab/resume-mcp/internal/types"
 )
 
@@ -18,6 +20,9 @@ func NewGetResumeByNameTool(db *database.Database) (mcp.Tool, server.ToolHandler
 			mcp.Required(),
 			mcp.Description("The name of the resume to retrieve"),
 		),
+		mcp.WithBoolean("plain_text",
+			mcp.Description("Return the description and feature map values as plain text with Markdown formatting removed (default: false)"),
+		),
 	)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -34,6 +39,10 @@ func NewGetResumeByNameTool(db *database.Database) (mcp.Tool, server.ToolHandler
 			return mcp.NewToolResultError(fmt.Sprintf("Resume not found: %v", err)), nil
 		}
 
+		if request.GetBool("plain_text", false) {
+			plainTextResume(resume)
+		}
+
 		resultJSON, _ := json.Marshal(resume)
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
@@ -45,3 +54,23 @@ func NewGetResumeByNameTool(db *database.Database) (mcp.Tool, server.ToolHandler
 
 	return tool, handler
 }
+
+// plainTextResume replaces the Markdown of the resume's description and feature map values with plain text
+func plainTextResume(resume *models.Resume) {
+	resume.Description = markdown.ToText(resume.Description)
+
+	plainTextFeatureMaps := func(featureMaps []models.FeatureMap) {
+		for i := range featureMaps {
+			featureMaps[i].Value = markdown.ToText(featureMaps[i].Value)
+		}
+	}
+	for _, experience := range resume.WorkExperiences {
+		plainTextFeatureMaps(experience.FeatureMaps)
+	}
+	for _, education := range resume.Educations {
+		plainTextFeatureMaps(education.FeatureMaps)
+	}
+	for _, experience := range resume.OtherExperiences {
+		plainTextFeatureMaps(experience.FeatureMaps)
+	}
+}
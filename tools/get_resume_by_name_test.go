@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func TestGetResumeByNameTool_PlainText(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	resume.Description = "Engineer focused on **payments**"
+	if err := db.UpdateResume(resume, &testUserID); err != nil {
+		t.Fatalf("Failed to update resume: %v", err)
+	}
+	other := &models.OtherExperience{ResumeID: resume.ID, Category: "Projects"}
+	if err := db.AddOtherExperience(other, &testUserID); err != nil {
+		t.Fatalf("Failed to add other experience: %v", err)
+	}
+	featureMap := &models.FeatureMap{ExperienceID: other.ID, Key: "Highlights", Value: "- Built [checkout](https://example.com)\n  - Cut latency by *40%*"}
+	if err := db.AddFeatureMap(featureMap, &testUserID); err != nil {
+		t.Fatalf("Failed to add feature map: %v", err)
+	}
+
+	_, handler := NewGetResumeByNameTool(db)
+
+	getResume := func(plainText bool) models.Resume {
+		result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+			"name":       resume.Name,
+			"plain_text": plainText,
+		}))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Expected success, got error: %v", result.Content[0].(mcp.TextContent).Text)
+		}
+		var got models.Resume
+		if err := json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &got); err != nil {
+			t.Fatalf("Failed to parse resume: %v", err)
+		}
+		return got
+	}
+
+	raw := getResume(false)
+	if raw.Description != resume.Description || raw.OtherExperiences[0].FeatureMaps[0].Value != featureMap.Value {
+		t.Errorf("Expected Markdown to be returned unchanged, got %q and %q", raw.Description, raw.OtherExperiences[0].FeatureMaps[0].Value)
+	}
+
+	plain := getResume(true)
+	if plain.Description != "Engineer focused on payments" {
+		t.Errorf("Unexpected plain text description: %q", plain.Description)
+	}
+	expected := "- Built checkout (https://example.com)\n  - Cut latency by 40%"
+	if value := plain.OtherExperiences[0].FeatureMaps[0].Value; value != expected {
+		t.Errorf("Unexpected plain text feature map value: %q, want %q", value, expected)
+	}
+}
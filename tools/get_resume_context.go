@@ -11,6 +11,7 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/resume-mcp/internal/database"
 	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
 	"github.com/rxtech-lab/resume-mcp/internal/types"
 )
 
@@ -29,6 +30,9 @@ The schema includes all resume fields, relationships, and nested structures:
 - Other experiences array with feature maps
 - Feature maps for flexible JSON data storage
 
+The context also lists the helper functions available to templates, e.g. sortByDate, groupBy,
+duration, join and upper, each with its signature, description and an example.
+
 No actual resume data is returned - only the schema structure.`),
 		mcp.WithString("resume_id",
 			mcp.Required(),
@@ -71,7 +75,8 @@ No actual resume data is returned - only the schema structure.`),
 
 		// Create context response with JSON schema
 		contextData := map[string]interface{}{
-			"json_schema": json.RawMessage(schemaJSON),
+			"json_schema":        json.RawMessage(schemaJSON),
+			"template_functions": service.TemplateFunctions(),
 		}
 
 		result := map[string]interface{}{
@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/rxtech-lab/resume-mcp/internal/database"
 	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
 )
 
 
@@ -381,4 +383,42 @@ func TestGetResumeContextTool_JSONSchemaContent(t *testing.T) {
 	if !strings.Contains(jsonContent.Text, "\"name\"") {
 		t.Errorf("Expected JSON schema to contain name field, got: %s", jsonContent.Text)
 	}
-}
\ No newline at end of file
+}
+func TestGetResumeContextTool_TemplateFunctions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_ = createTestResume(t, db)
+
+	_, handler := NewGetResumeContextTool(db)
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id": "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	var response struct {
+		Context struct {
+			TemplateFunctions []service.TemplateFunction `json:"template_functions"`
+		} `json:"context"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	functions := map[string]service.TemplateFunction{}
+	for _, function := range response.Context.TemplateFunctions {
+		functions[function.Name] = function
+	}
+	for _, name := range []string{"groupBy", "sortByDate", "duration", "join", "upper", "dateRange"} {
+		function, ok := functions[name]
+		if !ok {
+			t.Errorf("Expected template function %q to be listed", name)
+			continue
+		}
+		if function.Signature == "" || function.Description == "" || function.Example == "" {
+			t.Errorf("Expected template function %q to be documented, got %+v", name, function)
+		}
+	}
+}
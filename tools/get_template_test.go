@@ -11,7 +11,7 @@ import (
 
 func createTestTemplate(t *testing.T, db *database.Database, resumeID uint) *models.Template {
 	template := &models.Template{
-		ResumeID:     resumeID,
+		ResumeID:     &resumeID,
 		Name:         "Test Template",
 		Description:  "A test template",
 		TemplateData: "<h1>{{.Name}}</h1>",
@@ -158,7 +158,7 @@ func TestGetTemplateTool_WithAllFields(t *testing.T) {
 	
 	resume := createTestResume(t, db)
 	template := &models.Template{
-		ResumeID:     resume.ID,
+		ResumeID:     &resume.ID,
 		Name:         "Comprehensive Template",
 		Description:  "A template with all fields filled",
 		TemplateData: `<div class="resume">
@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/linkedin"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+// maxLinkedInArchiveSize limits the size of the uploaded or read export archive
+const maxLinkedInArchiveSize = 50 << 20
+
+// NewImportLinkedInTool creates the import_linkedin tool. Reading the archive from a path is only
+// offered when allowPaths is set, so the HTTP server doesn't read files of the machine it runs on.
+func NewImportLinkedInTool(db *database.Database, allowPaths bool) (mcp.Tool, server.ToolHandlerFunc) {
+	description := "Create a new resume from a LinkedIn data export, the ZIP archive of CSV files downloaded from LinkedIn under Settings > Data privacy > Get a copy of your data. Profile.csv becomes the name, summary and contacts, Positions.csv the work experiences, Education.csv the educations and Skills.csv a Skills section, Email Addresses.csv and PhoneNumbers.csv are added as contacts. Rows that can't be mapped are skipped and reported. Returns the created resume ID."
+	options := []mcp.ToolOption{
+		mcp.WithString("data",
+			mcp.Description("The ZIP archive encoded as base64"),
+		),
+		mcp.WithString("name",
+			mcp.Description("Name of the resume owner, replaces the name of Profile.csv (optional)"),
+		),
+	}
+	if allowPaths {
+		description += " Give either the path of the archive or its base64 encoded data."
+		options = append(options, mcp.WithString("path",
+			mcp.Description("Path of the ZIP archive on this computer"),
+		))
+	}
+	tool := mcp.NewTool("import_linkedin", append([]mcp.ToolOption{mcp.WithDescription(description)}, options...)...)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		data := request.GetString("data", "")
+		path := request.GetString("path", "")
+
+		var archive []byte
+		switch {
+		case data != "" && path != "":
+			return mcp.NewToolResultError("Give either data or path, not both"), nil
+		case data != "":
+			if base64.StdEncoding.DecodedLen(len(data)) > maxLinkedInArchiveSize {
+				return mcp.NewToolResultError(fmt.Sprintf("The archive is larger than %d MB", maxLinkedInArchiveSize>>20)), nil
+			}
+			decoded, err := base64.StdEncoding.DecodeString(data)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid base64 data: %v", err)), nil
+			}
+			archive = decoded
+		case path != "":
+			if !allowPaths {
+				return mcp.NewToolResultError("Reading archives from a path is not supported by this server, upload the archive as base64 data instead"), nil
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error reading archive: %v", err)), nil
+			}
+			if info.Size() > maxLinkedInArchiveSize {
+				return mcp.NewToolResultError(fmt.Sprintf("The archive is larger than %d MB", maxLinkedInArchiveSize>>20)), nil
+			}
+			archive, err = os.ReadFile(path)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error reading archive: %v", err)), nil
+			}
+		default:
+			return mcp.NewToolResultError("The archive is required, give its base64 encoded data or its path"), nil
+		}
+
+		resume, skipped, err := linkedin.Import(archive)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error importing LinkedIn export: %v", err)), nil
+		}
+
+		if name := request.GetString("name", ""); name != "" {
+			resume.Name = name
+		}
+		if resume.Name == "" {
+			return mcp.NewToolResultError("The export has no name in Profile.csv, give the name parameter"), nil
+		}
+
+		if err := db.ImportResume(resume, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating resume: %v", err)), nil
+		}
+
+		content := []mcp.Content{
+			mcp.NewTextContent("Resume imported successfully"),
+			mcp.NewTextContent(fmt.Sprintf("Resume ID: %d", resume.ID)),
+			mcp.NewTextContent(fmt.Sprintf("Imported %d contacts, %d work experiences, %d educations and %d other experiences", len(resume.Contacts), len(resume.WorkExperiences), len(resume.Educations), len(resume.OtherExperiences))),
+		}
+		if len(skipped) > 0 {
+			content = append(content, mcp.NewTextContent(fmt.Sprintf("Skipped %d rows that could not be mapped:", len(skipped))))
+			for _, row := range skipped {
+				content = append(content, mcp.NewTextContent("- "+row))
+			}
+		}
+
+		return &mcp.CallToolResult{
+			Content: content,
+		}, nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,127 @@
+package tools
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func createTestLinkedInExport(t *testing.T) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for name, content := range map[string]string{
+		"Profile.csv":   "First Name,Last Name,Headline,Summary\nJane,Doe,Backend Engineer,Builds payment systems.\n",
+		"Positions.csv": "Company Name,Title,Description,Location,Started On,Finished On\nFintech Inc,Staff Engineer,Led payments,Berlin,Jul 2021,\n,Intern,,,Jan 2015,Mar 2015\n",
+		"Skills.csv":    "Name\nGo\n",
+	} {
+		file, err := writer.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := file.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func TestImportLinkedInTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	tool, handler := NewImportLinkedInTool(db, false)
+	if tool.Name != "import_linkedin" {
+		t.Errorf("Expected tool name 'import_linkedin', got %s", tool.Name)
+	}
+	if _, ok := tool.InputSchema.Properties["path"]; ok {
+		t.Error("Expected no path parameter without local files")
+	}
+
+	result := callTool(t, handler, map[string]interface{}{
+		"data": base64.StdEncoding.EncodeToString(createTestLinkedInExport(t)),
+	})
+
+	var texts []string
+	for _, content := range result.Content {
+		texts = append(texts, content.(mcp.TextContent).Text)
+	}
+	text := strings.Join(texts, "\n")
+	for _, expected := range []string{"Resume ID: 1", "Skipped 1 rows that could not be mapped:", "- Positions.csv row 2: missing company name or title"} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("Expected result to contain %q, got:\n%s", expected, text)
+		}
+	}
+
+	resume, err := db.GetResumeByID(1, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get imported resume: %v", err)
+	}
+	if resume.Name != "Jane Doe" || resume.Description != "Builds payment systems." || len(resume.Contacts) != 1 || len(resume.WorkExperiences) != 1 || len(resume.OtherExperiences) != 1 {
+		t.Errorf("Unexpected imported resume: %+v", resume)
+	}
+	if resume.WorkExperiences[0].Company != "Fintech Inc" || resume.WorkExperiences[0].UserID != testUserID {
+		t.Errorf("Unexpected work experience: %+v", resume.WorkExperiences[0])
+	}
+}
+
+func TestImportLinkedInTool_Path(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	path := filepath.Join(t.TempDir(), "Basic_LinkedInDataExport.zip")
+	if err := os.WriteFile(path, createTestLinkedInExport(t), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, handler := NewImportLinkedInTool(db, true)
+	callTool(t, handler, map[string]interface{}{"path": path, "name": "J. Doe"})
+
+	resume, err := db.GetResumeByID(1, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get imported resume: %v", err)
+	}
+	if resume.Name != "J. Doe" {
+		t.Errorf("Expected the name parameter to replace the profile name, got %s", resume.Name)
+	}
+
+	_, handler = NewImportLinkedInTool(db, false)
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{"path": path}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected error reading a path without local files")
+	}
+}
+
+func TestImportLinkedInTool_Invalid(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, handler := NewImportLinkedInTool(db, true)
+	for _, args := range []map[string]interface{}{
+		{},
+		{"data": "not base64!"},
+		{"data": base64.StdEncoding.EncodeToString([]byte("not a zip"))},
+		{"data": "UEsFBgAAAAAAAAAAAAAAAAAAAAAAAA==", "path": "/tmp/export.zip"},
+		{"path": filepath.Join(t.TempDir(), "missing.zip")},
+	} {
+		result, err := handler(createTestContext(), createTestRequest(args))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected error importing %v", args)
+		}
+	}
+}
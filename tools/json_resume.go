@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/jsonresume"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewImportJSONResumeTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("import_json_resume",
+		mcp.WithDescription("Create a new resume from a JSON Resume document (https://jsonresume.org). Basics become the name, photo, description and contacts, work and education entries become work experiences and educations, and skills, projects and awards become other experiences. Sections without a counterpart, such as volunteer or languages, are skipped and reported. Returns the created resume ID."),
+		mcp.WithString("document",
+			mcp.Required(),
+			mcp.Description("The JSON Resume document as JSON. Dates must be in YYYY, YYYY-MM or YYYY-MM-DD format"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		data, ok, err := jsonArgument(request, "document")
+		if !ok {
+			return nil, fmt.Errorf("document parameter is required")
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid document: %v", err)), nil
+		}
+
+		document, warnings, err := jsonresume.Parse(data)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		resume, err := jsonresume.Import(document)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error importing JSON Resume: %v", err)), nil
+		}
+
+		if err := db.ImportResume(resume, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating resume: %v", err)), nil
+		}
+
+		content := []mcp.Content{
+			mcp.NewTextContent("Resume imported successfully"),
+			mcp.NewTextContent(fmt.Sprintf("Resume ID: %d", resume.ID)),
+			mcp.NewTextContent(fmt.Sprintf("Imported %d contacts, %d work experiences, %d educations and %d other experiences", len(resume.Contacts), len(resume.WorkExperiences), len(resume.Educations), len(resume.OtherExperiences))),
+		}
+		for _, warning := range warnings {
+			content = append(content, mcp.NewTextContent("Warning: "+warning))
+		}
+
+		return &mcp.CallToolResult{
+			Content: content,
+		}, nil
+	}
+
+	return tool, handler
+}
+
+func NewExportJSONResumeTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("export_json_resume",
+		mcp.WithDescription("Export a resume as a JSON Resume document (https://jsonresume.org) for use with other resume tools and themes. Resumes imported with import_json_resume are exported unchanged."),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("The ID of the resume to export"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		resumeIDStr, err := request.RequireString("resume_id")
+		if err != nil {
+			return nil, fmt.Errorf("resume_id parameter is required: %w", err)
+		}
+
+		resumeID, err := strconv.ParseUint(resumeIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id: %v", err)), nil
+		}
+
+		resume, err := db.GetResumeByID(uint(resumeID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting resume: %v", err)), nil
+		}
+
+		data, err := json.MarshalIndent(jsonresume.Export(*resume), "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error exporting resume: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(string(data)), nil
+	}
+
+	return tool, handler
+}
@@ -0,0 +1,119 @@
+package tools
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// The document only has work entries with feature maps: feature maps reference their experience by
+// ID alone, so the maps of a work experience and an education with the same ID can't be told apart
+const testJSONResume = `{
+  "$schema": "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json",
+  "basics": {
+    "name": "Jane Doe",
+    "label": "Backend Engineer",
+    "email": "jane@example.com",
+    "location": {"city": "Berlin", "countryCode": "DE"},
+    "profiles": [{"network": "GitHub", "username": "janedoe", "url": "https://github.com/janedoe"}]
+  },
+  "work": [
+    {"name": "Fintech Inc", "position": "Staff Engineer", "startDate": "2021-07", "summary": "Payments platform", "highlights": ["Cut latency by 40%", "Led a team of 5"]},
+    {"name": "Bank Corp", "position": "Engineer", "startDate": "2016", "endDate": "2021-06-30", "url": "https://bank.example"}
+  ]
+}`
+
+func TestImportExportJSONResumeTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	importTool, importHandler := NewImportJSONResumeTool(db)
+	if importTool.Name != "import_json_resume" {
+		t.Errorf("Expected tool name 'import_json_resume', got %s", importTool.Name)
+	}
+	exportTool, exportHandler := NewExportJSONResumeTool(db)
+	if exportTool.Name != "export_json_resume" {
+		t.Errorf("Expected tool name 'export_json_resume', got %s", exportTool.Name)
+	}
+
+	result := callTool(t, importHandler, map[string]interface{}{"document": testJSONResume})
+	if text := result.Content[1].(mcp.TextContent).Text; text != "Resume ID: 1" {
+		t.Errorf("Expected resume ID, got %s", text)
+	}
+
+	resume, err := db.GetResumeByID(1, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get imported resume: %v", err)
+	}
+	if resume.Name != "Jane Doe" || len(resume.Contacts) != 6 || len(resume.WorkExperiences) != 2 {
+		t.Errorf("Unexpected imported resume: %+v", resume)
+	}
+	if resume.WorkExperiences[0].UserID != testUserID || resume.WorkExperiences[0].FeatureMaps[0].UserID != testUserID {
+		t.Error("Expected imported entries to belong to the user")
+	}
+
+	result = callTool(t, exportHandler, map[string]interface{}{"resume_id": "1"})
+	var expected, actual any
+	if err := json.Unmarshal([]byte(testJSONResume), &expected); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &actual); err != nil {
+		t.Fatalf("Expected JSON export: %v", err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Round trip changed the document:\n%s", result.Content[0].(mcp.TextContent).Text)
+	}
+}
+
+func TestImportJSONResumeTool_Object(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, handler := NewImportJSONResumeTool(db)
+	result := callTool(t, handler, map[string]interface{}{
+		"document": map[string]interface{}{
+			"basics":    map[string]interface{}{"name": "Jane Doe"},
+			"education": []interface{}{map[string]interface{}{"institution": "TU Berlin", "studyType": "Master"}},
+			"volunteer": []interface{}{map[string]interface{}{"organization": "Red Cross"}},
+		},
+	})
+
+	text := result.Content[len(result.Content)-1].(mcp.TextContent).Text
+	if !strings.Contains(text, "volunteer") {
+		t.Errorf("Expected warning about the volunteer section, got %s", text)
+	}
+	resume, err := db.GetResumeByID(1, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get imported resume: %v", err)
+	}
+	if len(resume.Educations) != 1 || resume.Educations[0].Category != "Master" {
+		t.Errorf("Unexpected educations: %+v", resume.Educations)
+	}
+}
+
+func TestImportJSONResumeTool_Invalid(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, handler := NewImportJSONResumeTool(db)
+	for _, document := range []string{
+		`not json`,
+		`{"basics": {"label": "No name"}}`,
+		`{"basics": {"name": "Jane"}, "work": [{"name": "Acme", "startDate": "last year"}]}`,
+	} {
+		result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{"document": document}))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected error importing %s", document)
+		}
+	}
+
+	if _, err := db.GetResumeByID(1, &testUserID); err == nil {
+		t.Error("Expected no resume to be created")
+	}
+}
@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/analysis"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewLintResumeTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	var ruleDescriptions []string
+	for _, rule := range analysis.DefaultRules() {
+		ruleDescriptions = append(ruleDescriptions, fmt.Sprintf("- %s: %s", rule.Name(), rule.Description()))
+	}
+
+	tool := mcp.NewTool("lint_resume",
+		mcp.WithDescription(`Check a resume for common quality problems.
+
+Available rules:
+`+strings.Join(ruleDescriptions, "\n")+`
+
+Every finding has a severity (error, warning or info), the type of the affected entity
+(resume, work_experience, education, other_experience or feature_map) and its ID,
+so it can be fixed directly with the matching update tool.`),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("ID of the resume to lint"),
+		),
+		mcp.WithString("rules",
+			mcp.Description("Comma separated rule names to run (default: all rules)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		resumeIDStr, err := request.RequireString("resume_id")
+		if err != nil {
+			return nil, fmt.Errorf("resume_id parameter is required: %w", err)
+		}
+
+		resumeID, err := strconv.ParseUint(resumeIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id: %v", err)), nil
+		}
+
+		rules := analysis.DefaultRules()
+		if names := request.GetString("rules", ""); names != "" {
+			var selected []string
+			for _, name := range strings.Split(names, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					selected = append(selected, name)
+				}
+			}
+			rules, err = analysis.SelectRules(rules, selected)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+
+		resume, err := db.GetResumeByID(uint(resumeID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting resume: %v", err)), nil
+		}
+
+		findings := analysis.Lint(resume, rules)
+		summary := map[string]int{
+			analysis.SeverityError:   0,
+			analysis.SeverityWarning: 0,
+			analysis.SeverityInfo:    0,
+		}
+		for _, finding := range findings {
+			summary[finding.Severity]++
+		}
+
+		result := map[string]any{
+			"resume_id": resume.ID,
+			"summary":   summary,
+			"findings":  findings,
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
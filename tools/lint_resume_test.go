@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/analysis"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+)
+
+func TestLintResumeTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	workExp := &models.WorkExperience{
+		ResumeID: resume.ID,
+		Company:  "Tech Corp",
+		JobTitle: "Software Engineer",
+	}
+	db.AddWorkExperience(workExp, &testUserID)
+	weak := &models.FeatureMap{
+		ExperienceID: workExp.ID,
+		Key:          "description",
+		Value:        "Responsible for maintaining the payment service",
+	}
+	db.AddFeatureMap(weak, &testUserID)
+
+	tool, handler := NewLintResumeTool(db)
+	if tool.Name != "lint_resume" {
+		t.Errorf("Expected tool name 'lint_resume', got %s", tool.Name)
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id": "1",
+		"rules":     "weak-opening-verb",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("Expected TextContent, got %T", result.Content[0])
+	}
+
+	var response struct {
+		Summary  map[string]int     `json:"summary"`
+		Findings []analysis.Finding `json:"findings"`
+	}
+	if err := json.Unmarshal([]byte(textContent.Text), &response); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+
+	if len(response.Findings) != 1 {
+		t.Fatalf("Expected one finding, got %+v", response.Findings)
+	}
+	finding := response.Findings[0]
+	if finding.EntityType != analysis.EntityFeatureMap || finding.EntityID != weak.ID {
+		t.Errorf("Expected finding for feature map %d, got %+v", weak.ID, finding)
+	}
+	if response.Summary[analysis.SeverityWarning] != 1 {
+		t.Errorf("Expected one warning in summary, got %v", response.Summary)
+	}
+}
+
+func TestLintResumeTool_Errors(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	createTestResume(t, db)
+	_, handler := NewLintResumeTool(db)
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:          "unknown rule",
+			args:          map[string]interface{}{"resume_id": "1", "rules": "long-bullet, no-such-rule"},
+			expectedError: "unknown lint rule: no-such-rule",
+		},
+		{
+			name:          "invalid resume_id",
+			args:          map[string]interface{}{"resume_id": "abc"},
+			expectedError: "Invalid resume_id",
+		},
+		{
+			name:          "resume not found",
+			args:          map[string]interface{}{"resume_id": "999"},
+			expectedError: "Error getting resume",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler(createTestContext(), createTestRequest(tt.args))
+			if err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+
+			textContent := result.Content[0].(mcp.TextContent)
+			if !strings.Contains(textContent.Text, tt.expectedError) {
+				t.Errorf("Expected '%s' error, got: %s", tt.expectedError, textContent.Text)
+			}
+		})
+	}
+}
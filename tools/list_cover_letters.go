@@ -0,0 +1,54 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewListCoverLettersTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_cover_letters",
+		mcp.WithDescription("List the cover letters of a resume, newest first. Sections are not included, use get_cover_letter for the full letter."),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("ID of the resume to list cover letters for"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		resumeIDStr, err := request.RequireString("resume_id")
+		if err != nil {
+			return nil, fmt.Errorf("resume_id parameter is required: %w", err)
+		}
+
+		resumeID, err := strconv.ParseUint(resumeIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id: %v", err)), nil
+		}
+
+		letters, err := db.ListCoverLettersByResumeID(uint(resumeID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list cover letters: %v", err)), nil
+		}
+
+		result := map[string]any{
+			"success":       true,
+			"cover_letters": letters,
+			"count":         len(letters),
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return mcp.NewToolResultText(fmt.Sprintf("Cover letters listed successfully: %s", string(resultJSON))), nil
+	}
+
+	return tool, handler
+}
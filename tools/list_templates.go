@@ -14,7 +14,7 @@ import (
 
 func NewListTemplatesTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("list_templates",
-		mcp.WithDescription("List all templates for a specific resume"),
+		mcp.WithDescription("List all templates for a specific resume, together with the user templates that can be used with any resume"),
 		mcp.WithString("resume_id",
 			mcp.Required(),
 			mcp.Description("ID of the resume to list templates for"),
@@ -40,10 +40,16 @@ func NewListTemplatesTool(db *database.Database) (mcp.Tool, server.ToolHandlerFu
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to list templates: %v", err)), nil
 		}
 
+		userTemplates, err := db.ListUserTemplates(userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list user templates: %v", err)), nil
+		}
+
 		result := map[string]interface{}{
-			"success":   true,
-			"templates": templates,
-			"count":     len(templates),
+			"success":        true,
+			"templates":      templates,
+			"count":          len(templates),
+			"user_templates": userTemplates,
 		}
 
 		resultJSON, _ := json.Marshal(result)
@@ -0,0 +1,272 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewCreatePartialTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("create_partial",
+		mcp.WithDescription(`Create a reusable template partial, e.g. a header, a contact block or a base layout. Partials belong to the user and every template of the user can include them by name.
+
+Include a partial:
+{{template "header" .}}
+
+A base layout declares sections with defaults:
+<main>{{template "header" .}}{{block "content" .}}<p>No content</p>{{end}}</main>
+
+A template extends it by rendering the layout and defining the sections it replaces:
+{{template "base" .}}
+{{define "content"}}{{range .WorkExperiences}}<h3>{{.JobTitle}}</h3>{{end}}{{end}}`),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name templates include the partial by. Starts with a letter and contains only letters, digits, - and _"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Description of the partial"),
+		),
+		mcp.WithString("template_data",
+			mcp.Required(),
+			mcp.Description("Go template string of the partial"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		name, err := request.RequireString("name")
+		if err != nil {
+			return nil, fmt.Errorf("name parameter is required: %w", err)
+		}
+
+		templateData, err := request.RequireString("template_data")
+		if err != nil {
+			return nil, fmt.Errorf("template_data parameter is required: %w", err)
+		}
+
+		if err := service.ValidatePartialName(name); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		partials, err := db.PartialSources(userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting partials: %v", err)), nil
+		}
+		if _, exists := partials[name]; exists {
+			return mcp.NewToolResultError(fmt.Sprintf("Partial %q already exists, use update_partial to change it", name)), nil
+		}
+
+		if err := service.ValidatePartial(name, templateData, partials); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Partial validation failed: %v", err)), nil
+		}
+
+		partial := &models.Partial{
+			Name:         name,
+			Description:  request.GetString("description", ""),
+			TemplateData: templateData,
+		}
+		if err := db.CreatePartial(partial, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create partial: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Created partial %q successfully (partial_id: %d)", partial.Name, partial.ID)), nil
+	}
+
+	return tool, handler
+}
+
+func NewListPartialsTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_partials",
+		mcp.WithDescription("List the user's template partials with their template data"),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		partials, err := db.ListPartials(userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list partials: %v", err)), nil
+		}
+
+		result := map[string]any{
+			"partials": partials,
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
+
+func NewUpdatePartialTool(db *database.Database, templateService *service.TemplateService) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("update_partial",
+		mcp.WithDescription("Update the description or template data of a partial. Every template including the partial renders the new version, so new template data is rejected when a template including it fails to render with it."),
+		mcp.WithString("partial_id",
+			mcp.Required(),
+			mcp.Description("ID of the partial to update"),
+		),
+		mcp.WithString("description",
+			mcp.Description("New description (optional)"),
+		),
+		mcp.WithString("template_data",
+			mcp.Description("New template data (optional)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		partialIDStr, err := request.RequireString("partial_id")
+		if err != nil {
+			return nil, fmt.Errorf("partial_id parameter is required: %w", err)
+		}
+
+		partialID, err := strconv.ParseUint(partialIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid partial_id: %v", err)), nil
+		}
+
+		partial, err := db.GetPartialByID(uint(partialID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Partial not found: %v", err)), nil
+		}
+
+		if description := request.GetString("description", ""); description != "" {
+			partial.Description = description
+		}
+
+		if templateData := request.GetString("template_data", ""); templateData != "" {
+			partials, err := db.PartialSources(userID)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error getting partials: %v", err)), nil
+			}
+			if err := service.ValidatePartial(partial.Name, templateData, partials); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Partial validation failed: %v", err)), nil
+			}
+
+			dependents, err := partialDependents(db, partial.Name, partials, userID)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			partials[partial.Name] = templateData
+			if failures := renderPartialDependents(db, templateService.WithPartials(partials), dependents, userID); len(failures) > 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("Partial update breaks the templates including it: %s", strings.Join(failures, "; "))), nil
+			}
+			partial.TemplateData = templateData
+		}
+
+		if err := db.UpdatePartial(partial, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update partial: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Partial %q updated successfully", partial.Name)), nil
+	}
+
+	return tool, handler
+}
+
+func NewDeletePartialTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("delete_partial",
+		mcp.WithDescription("Delete a partial by ID. Partials that templates still include, directly or through other partials, can't be deleted."),
+		mcp.WithString("partial_id",
+			mcp.Required(),
+			mcp.Description("ID of the partial to delete"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		partialIDStr, err := request.RequireString("partial_id")
+		if err != nil {
+			return nil, fmt.Errorf("partial_id parameter is required: %w", err)
+		}
+
+		partialID, err := strconv.ParseUint(partialIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid partial_id: %v", err)), nil
+		}
+
+		partial, err := db.GetPartialByID(uint(partialID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Partial not found: %v", err)), nil
+		}
+
+		partials, err := db.PartialSources(userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting partials: %v", err)), nil
+		}
+		dependents, err := partialDependents(db, partial.Name, partials, userID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if len(dependents) > 0 {
+			names := make([]string, len(dependents))
+			for i, template := range dependents {
+				names[i] = describePartialDependent(template)
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Partial %q is included by %s, remove it from them first", partial.Name, strings.Join(names, ", "))), nil
+		}
+
+		if err := db.DeletePartial(partial.ID, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to delete partial: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Partial deleted successfully: %s", partial.Name)), nil
+	}
+
+	return tool, handler
+}
+
+// partialDependents returns the user's templates that include the partial, directly or through other partials
+func partialDependents(db *database.Database, name string, partials service.Partials, userID *string) ([]models.Template, error) {
+	templates, err := db.ListTemplatesWithData(userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting templates: %w", err)
+	}
+	return service.TemplatesIncludingPartial(templates, name, partials), nil
+}
+
+// renderPartialDependents renders the templates with the template service's partials
+// and returns a description of each template that fails
+func renderPartialDependents(db *database.Database, templateService *service.TemplateService, templates []models.Template, userID *string) []string {
+	var failures []string
+	for _, template := range templates {
+		resume, err := templateValidationResume(db, &template, userID)
+		if err == nil {
+			_, err = templateService.GeneratePreview(template.TemplateData, "", templateSampleData(template.Type, *resume))
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", describePartialDependent(template), err))
+		}
+	}
+	return failures
+}
+
+func describePartialDependent(template models.Template) string {
+	return fmt.Sprintf("template %q (template_id: %d)", template.Name, template.ID)
+}
+
+// userTemplateService returns the template service resolving the partials of the user
+func userTemplateService(db *database.Database, templateService *service.TemplateService, userID *string) (*service.TemplateService, error) {
+	partials, err := db.PartialSources(userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting partials: %w", err)
+	}
+	return templateService.WithPartials(partials), nil
+}
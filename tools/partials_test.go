@@ -0,0 +1,219 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+func TestCreatePartialTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	tool, handler := NewCreatePartialTool(db)
+	if tool.Name != "create_partial" {
+		t.Errorf("Expected tool name 'create_partial', got %s", tool.Name)
+	}
+
+	result := callTool(t, handler, map[string]interface{}{
+		"name":          "header",
+		"description":   "Name and contacts",
+		"template_data": `<header>{{.Name}}</header>`,
+	})
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, `Created partial "header"`) {
+		t.Errorf("Expected success message, got: %s", text)
+	}
+
+	partials, err := db.ListPartials(&testUserID)
+	if err != nil {
+		t.Fatalf("Failed to list partials: %v", err)
+	}
+	if len(partials) != 1 || partials[0].Name != "header" || partials[0].UserID != testUserID {
+		t.Errorf("Unexpected partials: %+v", partials)
+	}
+
+	invalid := []map[string]interface{}{
+		{"name": "header", "template_data": "<p>duplicate</p>"},
+		{"name": "my header", "template_data": "<p>invalid name</p>"},
+		{"name": "broken", "template_data": "{{if .Name}}"},
+		{"name": "loop", "template_data": `{{template "loop" .}}`},
+	}
+	for _, args := range invalid {
+		result, err := handler(createTestContext(), createTestRequest(args))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected error creating partial %v", args)
+		}
+	}
+}
+
+func TestUpdatePartialTool_Recursive(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	for _, partial := range []*models.Partial{
+		{Name: "a", TemplateData: `{{template "b" .}}`},
+		{Name: "b", TemplateData: `<p>b</p>`},
+	} {
+		if err := db.CreatePartial(partial, &testUserID); err != nil {
+			t.Fatalf("Failed to create partial: %v", err)
+		}
+	}
+
+	_, handler := NewUpdatePartialTool(db, service.NewTemplateService())
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"partial_id":    "2",
+		"template_data": `{{template "a" .}}`,
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !result.IsError || !strings.Contains(text, "b -> a -> b") {
+		t.Errorf("Expected recursion error, got: %s", text)
+	}
+
+	callTool(t, handler, map[string]interface{}{
+		"partial_id":    "2",
+		"template_data": `<p>updated</p>`,
+	})
+	partial, err := db.GetPartialByID(2, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get partial: %v", err)
+	}
+	if partial.TemplateData != `<p>updated</p>` {
+		t.Errorf("Expected updated template data, got %q", partial.TemplateData)
+	}
+}
+
+func TestTemplateValidation_Partials(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	if err := db.CreatePartial(&models.Partial{
+		Name:         "base",
+		TemplateData: `<main>{{block "content" .}}{{end}}</main>`,
+	}, &testUserID); err != nil {
+		t.Fatalf("Failed to create partial: %v", err)
+	}
+
+	_, createHandler := NewCreateTemplateTool(db, service.NewTemplateService())
+
+	result, err := createHandler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id":     "1",
+		"name":          "Missing partial",
+		"template_data": `{{template "header" .}}`,
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !result.IsError || !strings.Contains(text, `partial "header", which doesn't exist`) {
+		t.Errorf("Expected missing partial error, got: %s", text)
+	}
+
+	callTool(t, createHandler, map[string]interface{}{
+		"resume_id":     "1",
+		"name":          "Extends base",
+		"template_data": `{{template "base" .}}{{define "content"}}<h1>{{.Name}}</h1>{{end}}`,
+	})
+
+	_, updateHandler := NewUpdateTemplateTool(db, service.NewTemplateService())
+	result, err = updateHandler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id":   "1",
+		"template_data": `{{template "base" .}}{{define "content"}}{{template "base" .}}{{end}}`,
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !result.IsError || !strings.Contains(text, "recursive") {
+		t.Errorf("Expected recursion error, got: %s", text)
+	}
+
+	_, previewHandler := NewGeneratePreviewTool(db, "8080", service.NewTemplateService())
+	callTool(t, previewHandler, map[string]interface{}{
+		"resume_id":   "1",
+		"template_id": "1",
+	})
+
+	partials, err := db.PartialSources(&testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get partials: %v", err)
+	}
+	html, err := service.NewTemplateService().WithPartials(partials).GeneratePreview(`{{template "base" .}}{{define "content"}}<h1>{{.Name}}</h1>{{end}}`, "", *resume)
+	if err != nil {
+		t.Fatalf("GeneratePreview() error = %v", err)
+	}
+	if !strings.Contains(html, "<main><h1>Test User</h1></main>") {
+		t.Errorf("Expected the template to fill the layout, got %s", html)
+	}
+}
+
+func TestPartialTools_Dependents(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	header := &models.Partial{Name: "header", TemplateData: `<h1>{{.Name}}</h1>`}
+	if err := db.CreatePartial(header, &testUserID); err != nil {
+		t.Fatalf("Failed to create partial: %v", err)
+	}
+	layout := &models.Partial{Name: "layout", TemplateData: `<main>{{template "header" .}}</main>`}
+	if err := db.CreatePartial(layout, &testUserID); err != nil {
+		t.Fatalf("Failed to create partial: %v", err)
+	}
+	// The resume template includes the header through the layout
+	if err := db.CreateTemplate(&models.Template{ResumeID: &resume.ID, Name: "Resume layout", TemplateData: `{{template "layout" .}}`}, &testUserID); err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+	if err := db.CreateTemplate(&models.Template{Name: "Shared header", TemplateData: `{{template "header" .}}`}, &testUserID); err != nil {
+		t.Fatalf("Failed to create user template: %v", err)
+	}
+
+	_, updateHandler := NewUpdatePartialTool(db, service.NewTemplateService())
+	result, err := updateHandler(createTestContext(), createTestRequest(map[string]interface{}{
+		"partial_id":    "1",
+		"template_data": `<h1>{{.Missing}}</h1>`,
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if !result.IsError || !strings.Contains(text, `template "Resume layout" (template_id: 1)`) || !strings.Contains(text, `template "Shared header" (template_id: 2)`) {
+		t.Errorf("Expected the update to be rejected naming both templates, got: %s", text)
+	}
+	if partial, _ := db.GetPartialByID(header.ID, &testUserID); partial.TemplateData != header.TemplateData {
+		t.Errorf("Expected the partial to be unchanged, got %q", partial.TemplateData)
+	}
+
+	callTool(t, updateHandler, map[string]interface{}{
+		"partial_id":    "1",
+		"template_data": `<h1 class="name">{{.Name}}</h1>`,
+	})
+
+	_, deleteHandler := NewDeletePartialTool(db)
+	result, err = deleteHandler(createTestContext(), createTestRequest(map[string]interface{}{
+		"partial_id": "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !result.IsError || !strings.Contains(text, `template "Shared header" (template_id: 2)`) {
+		t.Errorf("Expected the delete to be rejected naming the templates, got: %s", text)
+	}
+
+	unused := &models.Partial{Name: "unused", TemplateData: `<p></p>`}
+	if err := db.CreatePartial(unused, &testUserID); err != nil {
+		t.Fatalf("Failed to create partial: %v", err)
+	}
+	callTool(t, deleteHandler, map[string]interface{}{
+		"partial_id": "3",
+	})
+	if _, err := db.GetPartialByID(unused.ID, &testUserID); err == nil {
+		t.Error("Expected the unused partial to be deleted")
+	}
+}
@@ -0,0 +1,33 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+// newPDFProgressReporter forwards PDF generation stages to the client as
+// notifications/progress messages. It is a no-op when the client did not
+// send a progress token with the request.
+func newPDFProgressReporter(ctx context.Context, request mcp.CallToolRequest) service.PDFProgressFunc {
+	if request.Params.Meta == nil || request.Params.Meta.ProgressToken == nil {
+		return nil
+	}
+	srv := server.ServerFromContext(ctx)
+	if srv == nil {
+		return nil
+	}
+
+	token := request.Params.Meta.ProgressToken
+	return func(stage string, step int, total int) {
+		// Progress is best effort, a failed notification must not abort rendering
+		_ = srv.SendNotificationToClient(ctx, "notifications/progress", map[string]any{
+			"progressToken": token,
+			"progress":      step,
+			"total":         total,
+			"message":       stage,
+		})
+	}
+}
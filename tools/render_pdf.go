@@ -0,0 +1,120 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewRenderPDFTool(db *database.Database, templateService *service.TemplateService) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("render_pdf",
+		mcp.WithDescription("Render a resume to PDF using a saved template and return the PDF as an embedded resource. Sends progress notifications (template rendered, browser acquired, page loaded, PDF printed) when the request includes a progress token, and stops when the client cancels the request."),
+		mcp.WithString("resume_id",
+			mcp.Required(),
+			mcp.Description("The ID of the resume to render"),
+		),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("The ID of the template to use for rendering, a template of the resume or a user template"),
+		),
+		mcp.WithString("css",
+			mcp.Description("Additional CSS styles for the PDF (optional, Tailwind CSS classes are available in templates)"),
+		),
+		mcp.WithNumber("timeout_seconds",
+			mcp.Description("Maximum time in seconds the browser may take to render the PDF (default: 30)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		templateService, err := userTemplateService(db, templateService, userID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		resumeIDStr, err := request.RequireString("resume_id")
+		if err != nil {
+			return nil, fmt.Errorf("resume_id parameter is required: %w", err)
+		}
+
+		resumeID, err := strconv.ParseUint(resumeIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid resume_id: %v", err)), nil
+		}
+
+		templateIDStr, err := request.RequireString("template_id")
+		if err != nil {
+			return nil, fmt.Errorf("template_id parameter is required: %w", err)
+		}
+
+		templateID, err := strconv.ParseUint(templateIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid template_id: %v", err)), nil
+		}
+
+		css := request.GetString("css", "")
+
+		timeoutSeconds := request.GetFloat("timeout_seconds", service.DefaultPDFTimeout.Seconds())
+		if timeoutSeconds <= 0 {
+			return mcp.NewToolResultError("timeout_seconds must be greater than 0"), nil
+		}
+
+		resume, err := db.GetResumeByID(uint(resumeID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting resume: %v", err)), nil
+		}
+
+		template, err := db.GetTemplateByID(uint(templateID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error getting template: %v", err)), nil
+		}
+
+		// Verify template belongs to the same resume or to the user
+		if !template.UsableWith(uint(resumeID)) {
+			return mcp.NewToolResultError("Template does not belong to the specified resume"), nil
+		}
+
+		if template.Type == models.TemplateTypeCoverLetter {
+			return mcp.NewToolResultError("Template is a cover letter template, use generate_cover_letter_preview with include_pdf instead"), nil
+		}
+
+		if template.IsLaTeX() {
+			return mcp.NewToolResultError("Template is a LaTeX template, use export_latex with compile instead"), nil
+		}
+
+		timeout := time.Duration(timeoutSeconds * float64(time.Second))
+		pdf, err := templateService.GeneratePDFWithProgress(ctx, template.TemplateData, css, *resume, timeout, newPDFProgressReporter(ctx, request))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error rendering PDF: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("PDF rendered successfully (%d bytes)", len(pdf))),
+				newPDFResource(uint(resumeID), pdf),
+			},
+		}, nil
+	}
+
+	return tool, handler
+}
+
+// newPDFResource wraps a rendered PDF as an embedded resource for tool results
+func newPDFResource(resumeID uint, pdf []byte) mcp.EmbeddedResource {
+	return mcp.NewEmbeddedResource(mcp.BlobResourceContents{
+		URI:      fmt.Sprintf("resume://%d/pdf", resumeID),
+		MIMEType: "application/pdf",
+		Blob:     base64.StdEncoding.EncodeToString(pdf),
+	})
+}
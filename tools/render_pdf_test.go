@@ -0,0 +1,97 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+func TestRenderPDFTool_Definition(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	tool, _ := NewRenderPDFTool(db, service.NewTemplateService())
+
+	if tool.Name != "render_pdf" {
+		t.Errorf("Expected tool name 'render_pdf', got %s", tool.Name)
+	}
+
+	if _, ok := tool.InputSchema.Properties["timeout_seconds"]; !ok {
+		t.Error("Expected timeout_seconds parameter")
+	}
+}
+
+func TestRenderPDFTool_Errors(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_ = createTestResume(t, db)
+	resume2 := createTestResume(t, db)
+	_ = createTestTemplate(t, db, resume2.ID)
+
+	_, handler := NewRenderPDFTool(db, service.NewTemplateService())
+
+	tests := []struct {
+		name          string
+		args          map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:          "invalid resume_id",
+			args:          map[string]interface{}{"resume_id": "invalid", "template_id": "1"},
+			expectedError: "Invalid resume_id",
+		},
+		{
+			name:          "non-existent resume",
+			args:          map[string]interface{}{"resume_id": "999", "template_id": "1"},
+			expectedError: "Error getting resume",
+		},
+		{
+			name:          "non-existent template",
+			args:          map[string]interface{}{"resume_id": "1", "template_id": "999"},
+			expectedError: "Error getting template",
+		},
+		{
+			name:          "template mismatch",
+			args:          map[string]interface{}{"resume_id": "1", "template_id": "1"},
+			expectedError: "Template does not belong to the specified resume",
+		},
+		{
+			name:          "invalid timeout",
+			args:          map[string]interface{}{"resume_id": "2", "template_id": "1", "timeout_seconds": 0},
+			expectedError: "timeout_seconds must be greater than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler(createTestContext(), createTestRequest(tt.args))
+			if err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+
+			if !result.IsError {
+				t.Error("Expected error result")
+			}
+
+			textContent, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				t.Fatalf("Expected TextContent, got %T", result.Content[0])
+			}
+
+			if !strings.Contains(textContent.Text, tt.expectedError) {
+				t.Errorf("Expected '%s' error, got: %s", tt.expectedError, textContent.Text)
+			}
+		})
+	}
+}
+
+func TestNewPDFProgressReporter_NoProgressToken(t *testing.T) {
+	request := createTestRequest(map[string]interface{}{})
+
+	if reporter := newPDFProgressReporter(createTestContext(), request); reporter != nil {
+		t.Error("Expected no reporter without a progress token")
+	}
+}
@@ -0,0 +1,271 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/analysis"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+// templateRevisionSummary is a revision as listed by list_template_revisions, without its template data
+type templateRevisionSummary struct {
+	Revision  int       `json:"revision"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+	Size      int       `json:"size"`
+}
+
+func NewListTemplateRevisionsTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("list_template_revisions",
+		mcp.WithDescription("List the saved revisions of a template, newest first, with their change notes and timestamps. A revision is recorded whenever the template data changes. Use get_template_revision to view one, diff_template_revisions to compare two and rollback_template to restore one."),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("ID of the template"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		template, errResult, err := templateArgument(db, request, userID)
+		if errResult != nil || err != nil {
+			return errResult, err
+		}
+
+		revisions, err := db.ListTemplateRevisions(template.ID, userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error listing template revisions: %v", err)), nil
+		}
+
+		summaries := make([]templateRevisionSummary, 0, len(revisions))
+		for _, revision := range revisions {
+			summaries = append(summaries, templateRevisionSummary{
+				Revision:  revision.Revision,
+				Note:      revision.Note,
+				CreatedAt: revision.CreatedAt,
+				Size:      len(revision.TemplateData),
+			})
+		}
+
+		result := map[string]any{
+			"template_id": template.ID,
+			"name":        template.Name,
+			"revisions":   summaries,
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
+
+func NewGetTemplateRevisionTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("get_template_revision",
+		mcp.WithDescription("Get a saved revision of a template including its template data"),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("ID of the template"),
+		),
+		mcp.WithString("revision",
+			mcp.Required(),
+			mcp.Description("Revision number, as listed by list_template_revisions"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		template, errResult, err := templateArgument(db, request, userID)
+		if errResult != nil || err != nil {
+			return errResult, err
+		}
+
+		revisionStr, err := request.RequireString("revision")
+		if err != nil {
+			return nil, fmt.Errorf("revision parameter is required: %w", err)
+		}
+
+		revision, errResult := templateRevisionArgument(db, template.ID, "revision", revisionStr, userID)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		result := map[string]any{
+			"revision": revision,
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return mcp.NewToolResultText(fmt.Sprintf("Template revision retrieved successfully: %s", string(resultJSON))), nil
+	}
+
+	return tool, handler
+}
+
+func NewDiffTemplateRevisionsTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("diff_template_revisions",
+		mcp.WithDescription("Compare two revisions of a template and return the changed lines as a unified diff"),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("ID of the template"),
+		),
+		mcp.WithString("from",
+			mcp.Required(),
+			mcp.Description("Revision number to compare from"),
+		),
+		mcp.WithString("to",
+			mcp.Description("Revision number to compare to (default: the latest revision)"),
+		),
+		mcp.WithNumber("context",
+			mcp.Description("Number of unchanged lines shown around each change (default: 3)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		template, errResult, err := templateArgument(db, request, userID)
+		if errResult != nil || err != nil {
+			return errResult, err
+		}
+
+		fromStr, err := request.RequireString("from")
+		if err != nil {
+			return nil, fmt.Errorf("from parameter is required: %w", err)
+		}
+
+		from, errResult := templateRevisionArgument(db, template.ID, "from", fromStr, userID)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		var to *models.TemplateRevision
+		if toStr := request.GetString("to", ""); toStr != "" {
+			to, errResult = templateRevisionArgument(db, template.ID, "to", toStr, userID)
+			if errResult != nil {
+				return errResult, nil
+			}
+		} else {
+			revisions, err := db.ListTemplateRevisions(template.ID, userID)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error listing template revisions: %v", err)), nil
+			}
+			to = &revisions[0]
+		}
+
+		diff := analysis.UnifiedDiff(from.TemplateData, to.TemplateData,
+			fmt.Sprintf("revision %d", from.Revision), fmt.Sprintf("revision %d", to.Revision),
+			request.GetInt("context", analysis.DefaultDiffContext))
+		if diff == "" {
+			return mcp.NewToolResultText(fmt.Sprintf("Revisions %d and %d of template %d are identical", from.Revision, to.Revision, template.ID)), nil
+		}
+
+		return mcp.NewToolResultText(diff), nil
+	}
+
+	return tool, handler
+}
+
+func NewRollbackTemplateTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("rollback_template",
+		mcp.WithDescription("Restore the template data of an earlier revision. The rollback is recorded as a new revision, so it can be undone by rolling back again."),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("ID of the template"),
+		),
+		mcp.WithString("revision",
+			mcp.Required(),
+			mcp.Description("Revision number to restore"),
+		),
+		mcp.WithString("change_note",
+			mcp.Description("Note recorded with the new revision (default: Rollback to revision N)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		template, errResult, err := templateArgument(db, request, userID)
+		if errResult != nil || err != nil {
+			return errResult, err
+		}
+
+		revisionStr, err := request.RequireString("revision")
+		if err != nil {
+			return nil, fmt.Errorf("revision parameter is required: %w", err)
+		}
+
+		revision, errResult := templateRevisionArgument(db, template.ID, "revision", revisionStr, userID)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		if template.TemplateData == revision.TemplateData {
+			return mcp.NewToolResultText(fmt.Sprintf("Template %d already matches revision %d", template.ID, revision.Revision)), nil
+		}
+
+		note := request.GetString("change_note", "")
+		if note == "" {
+			note = fmt.Sprintf("Rollback to revision %d", revision.Revision)
+		}
+
+		template.TemplateData = revision.TemplateData
+		if err := db.UpdateTemplateWithNote(template, note, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to roll back template: %v", err)), nil
+		}
+
+		revisions, err := db.ListTemplateRevisions(template.ID, userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error listing template revisions: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Template %d rolled back to revision %d, recorded as revision %d", template.ID, revision.Revision, revisions[0].Revision)), nil
+	}
+
+	return tool, handler
+}
+
+// templateArgument loads the template given by the template_id argument
+func templateArgument(db *database.Database, request mcp.CallToolRequest, userID *string) (*models.Template, *mcp.CallToolResult, error) {
+	templateIDStr, err := request.RequireString("template_id")
+	if err != nil {
+		return nil, nil, fmt.Errorf("template_id parameter is required: %w", err)
+	}
+
+	templateID, err := strconv.ParseUint(templateIDStr, 10, 32)
+	if err != nil {
+		return nil, mcp.NewToolResultError(fmt.Sprintf("Invalid template_id: %v", err)), nil
+	}
+
+	template, err := db.GetTemplateByID(uint(templateID), userID)
+	if err != nil {
+		return nil, mcp.NewToolResultError(fmt.Sprintf("Template not found: %v", err)), nil
+	}
+	return template, nil, nil
+}
+
+// templateRevisionArgument loads the revision of the template given by the named argument
+func templateRevisionArgument(db *database.Database, templateID uint, name, value string, userID *string) (*models.TemplateRevision, *mcp.CallToolResult) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return nil, mcp.NewToolResultError(fmt.Sprintf("Invalid %s: %s, must be a revision number", name, value))
+	}
+
+	revision, err := db.GetTemplateRevision(templateID, number, userID)
+	if err != nil {
+		return nil, mcp.NewToolResultError(fmt.Sprintf("Revision %d of template %d not found: %v", number, templateID, err))
+	}
+	return revision, nil
+}
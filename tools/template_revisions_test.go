@@ -0,0 +1,258 @@
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+// callTool calls the handler and fails the test unless it succeeds
+func callTool(t *testing.T, handler server.ToolHandlerFunc, args map[string]interface{}) *mcp.CallToolResult {
+	t.Helper()
+	result, err := handler(createTestContext(), createTestRequest(args))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error: %v", result.Content[0].(mcp.TextContent).Text)
+	}
+	return result
+}
+
+func TestTemplateRevisions_RecordedOnUpdate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	template := createTestTemplate(t, db, resume.ID)
+
+	_, updateHandler := NewUpdateTemplateTool(db, service.NewTemplateService())
+	callTool(t, updateHandler, map[string]interface{}{
+		"template_id":   "1",
+		"template_data": "<h2>{{.Name}}</h2>",
+		"change_note":   "Smaller heading",
+	})
+	// Changing only the name keeps the template data, so no revision is recorded
+	callTool(t, updateHandler, map[string]interface{}{
+		"template_id": "1",
+		"name":        "Renamed",
+	})
+
+	tool, handler := NewListTemplateRevisionsTool(db)
+	if tool.Name != "list_template_revisions" {
+		t.Errorf("Expected tool name 'list_template_revisions', got %s", tool.Name)
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id": "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+
+	var listed struct {
+		Revisions []templateRevisionSummary `json:"revisions"`
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &listed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(listed.Revisions) != 2 {
+		t.Fatalf("Expected 2 revisions, got %+v", listed.Revisions)
+	}
+	if listed.Revisions[0].Revision != 2 || listed.Revisions[0].Note != "Smaller heading" {
+		t.Errorf("Expected newest revision 2 with its note first, got %+v", listed.Revisions[0])
+	}
+	if listed.Revisions[1].Revision != 1 || listed.Revisions[1].CreatedAt.IsZero() {
+		t.Errorf("Expected revision 1 with a timestamp, got %+v", listed.Revisions[1])
+	}
+
+	revision, err := db.GetTemplateRevision(template.ID, 1, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get revision 1: %v", err)
+	}
+	if revision.TemplateData != template.TemplateData {
+		t.Errorf("Expected revision 1 to keep the original data, got %q", revision.TemplateData)
+	}
+}
+
+func TestTemplateRevisions_LegacyTemplate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	template := createTestTemplate(t, db, resume.ID)
+
+	// Templates created before revisions were kept have no history
+	if err := db.DB.Exec("DELETE FROM template_revisions").Error; err != nil {
+		t.Fatalf("Failed to delete revisions: %v", err)
+	}
+
+	template.TemplateData = "<h2>{{.Name}}</h2>"
+	if err := db.UpdateTemplateWithNote(template, "First tracked change", &testUserID); err != nil {
+		t.Fatalf("Failed to update template: %v", err)
+	}
+
+	revisions, err := db.ListTemplateRevisions(template.ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to list revisions: %v", err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("Expected the stored data and the update as revisions, got %d", len(revisions))
+	}
+	if revisions[1].TemplateData != "<h1>{{.Name}}</h1>" {
+		t.Errorf("Expected revision 1 to snapshot the stored data, got %q", revisions[1].TemplateData)
+	}
+	if revisions[0].TemplateData != template.TemplateData || revisions[0].Note != "First tracked change" {
+		t.Errorf("Unexpected revision 2: %+v", revisions[0])
+	}
+}
+
+func TestGetTemplateRevisionTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	_ = createTestTemplate(t, db, resume.ID)
+
+	_, handler := NewGetTemplateRevisionTool(db)
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id": "1",
+		"revision":    "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if result.IsError || !strings.Contains(text, "{{.Name}}") {
+		t.Errorf("Expected revision data, got: %s", text)
+	}
+
+	for _, revision := range []string{"2", "0", "latest"} {
+		result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+			"template_id": "1",
+			"revision":    revision,
+		}))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected error for revision %q", revision)
+		}
+	}
+}
+
+func TestDiffTemplateRevisionsTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	template := createTestTemplate(t, db, resume.ID)
+
+	template.TemplateData = "<h1 class=\"title\">{{.Name}}</h1>"
+	if err := db.UpdateTemplateWithNote(template, "Style heading", &testUserID); err != nil {
+		t.Fatalf("Failed to update template: %v", err)
+	}
+
+	_, handler := NewDiffTemplateRevisionsTool(db)
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id": "1",
+		"from":        "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	diff := result.Content[0].(mcp.TextContent).Text
+	for _, expected := range []string{"--- revision 1", "+++ revision 2", "-<h1>{{.Name}}</h1>", "+<h1 class=\"title\">{{.Name}}</h1>"} {
+		if !strings.Contains(diff, expected) {
+			t.Errorf("Expected diff to contain %q, got:\n%s", expected, diff)
+		}
+	}
+
+	result, err = handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id": "1",
+		"from":        "2",
+		"to":          "2",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "identical") {
+		t.Errorf("Expected identical revisions, got: %s", text)
+	}
+}
+
+func TestRollbackTemplateTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	template := createTestTemplate(t, db, resume.ID)
+	original := template.TemplateData
+
+	template.TemplateData = "<p>broken layout</p>"
+	if err := db.UpdateTemplateWithNote(template, "Bad edit", &testUserID); err != nil {
+		t.Fatalf("Failed to update template: %v", err)
+	}
+
+	tool, handler := NewRollbackTemplateTool(db)
+	if tool.Name != "rollback_template" {
+		t.Errorf("Expected tool name 'rollback_template', got %s", tool.Name)
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id": "1",
+		"revision":    "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; result.IsError || !strings.Contains(text, "recorded as revision 3") {
+		t.Fatalf("Expected rollback recorded as revision 3, got: %s", text)
+	}
+
+	restored, err := db.GetTemplateByID(template.ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if restored.TemplateData != original {
+		t.Errorf("Expected template data %q, got %q", original, restored.TemplateData)
+	}
+
+	revisions, err := db.ListTemplateRevisions(template.ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to list revisions: %v", err)
+	}
+	if revisions[0].Note != "Rollback to revision 1" {
+		t.Errorf("Expected default rollback note, got %q", revisions[0].Note)
+	}
+
+	// The bad edit is still in the history and can be restored again
+	if revisions[1].TemplateData != "<p>broken layout</p>" {
+		t.Errorf("Expected the bad edit to stay in the history, got %q", revisions[1].TemplateData)
+	}
+}
+
+func TestDeleteTemplate_DeletesRevisions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	template := createTestTemplate(t, db, resume.ID)
+
+	if err := db.DeleteTemplate(template.ID, &testUserID); err != nil {
+		t.Fatalf("Failed to delete template: %v", err)
+	}
+	revisions, err := db.ListTemplateRevisions(template.ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to list revisions: %v", err)
+	}
+	if len(revisions) != 0 {
+		t.Errorf("Expected revisions to be deleted with the template, got %d", len(revisions))
+	}
+}
@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewUpdateCoverLetterTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("update_cover_letter",
+		mcp.WithDescription("Update a cover letter. Only the given fields change. Passing sections replaces all sections of the letter."),
+		mcp.WithString("cover_letter_id",
+			mcp.Required(),
+			mcp.Description("ID of the cover letter to update"),
+		),
+		mcp.WithString("company",
+			mcp.Description("New company (optional)"),
+		),
+		mcp.WithString("recipient",
+			mcp.Description("New recipient (optional)"),
+		),
+		mcp.WithString("date",
+			mcp.Description("New date in YYYY-MM-DD format (optional)"),
+		),
+		mcp.WithArray("sections",
+			mcp.Description("New body sections replacing the existing ones (optional)"),
+			mcp.Items(coverLetterSectionsSchema),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		letterIDStr, err := request.RequireString("cover_letter_id")
+		if err != nil {
+			return nil, fmt.Errorf("cover_letter_id parameter is required: %w", err)
+		}
+
+		letterID, err := strconv.ParseUint(letterIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid cover_letter_id: %v", err)), nil
+		}
+
+		letter, err := db.GetCoverLetterByID(uint(letterID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Cover letter not found: %v", err)), nil
+		}
+
+		if company := request.GetString("company", ""); company != "" {
+			letter.Company = company
+		}
+
+		if recipient := request.GetString("recipient", ""); recipient != "" {
+			letter.Recipient = recipient
+		}
+
+		if dateStr := request.GetString("date", ""); dateStr != "" {
+			date, err := time.Parse("2006-01-02", dateStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid date format: %v", err)), nil
+			}
+			letter.Date = date
+		}
+
+		sections, replaceSections, err := sectionsArgument(request)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid sections: %v", err)), nil
+		}
+		if replaceSections {
+			letter.Sections = sections
+		}
+
+		if err := db.UpdateCoverLetter(letter, replaceSections, userID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update cover letter: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText("Cover letter updated successfully"), nil
+	}
+
+	return tool, handler
+}
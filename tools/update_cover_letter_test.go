@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestUpdateCoverLetterTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	letter := createTestCoverLetter(t, db, resume.ID)
+
+	_, handler := NewUpdateCoverLetterTool(db)
+
+	// Fields only, sections are kept
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"cover_letter_id": "1",
+		"company":         "Globex",
+	}))
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got %v %v", err, result)
+	}
+
+	updated, _ := db.GetCoverLetterByID(letter.ID, &testUserID)
+	if updated.Company != "Globex" || updated.Recipient != "Jane Smith" || len(updated.Sections) != 2 {
+		t.Errorf("Expected only the company to change, got %+v", updated)
+	}
+
+	// Sections are replaced
+	result, err = handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"cover_letter_id": "1",
+		"sections":        []interface{}{map[string]interface{}{"body": "A new letter."}},
+	}))
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got %v %v", err, result)
+	}
+
+	updated, _ = db.GetCoverLetterByID(letter.ID, &testUserID)
+	if len(updated.Sections) != 1 || updated.Sections[0].Body != "A new letter." {
+		t.Errorf("Expected sections to be replaced, got %+v", updated.Sections)
+	}
+}
+
+func TestDeleteCoverLetterTool(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	letter := createTestCoverLetter(t, db, resume.ID)
+
+	_, handler := NewDeleteCoverLetterTool(db)
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"cover_letter_id": "1",
+	}))
+	if err != nil || result.IsError {
+		t.Fatalf("Expected success, got %v %v", err, result)
+	}
+
+	if _, err := db.GetCoverLetterByID(letter.ID, &testUserID); err == nil {
+		t.Error("Expected cover letter to be deleted")
+	}
+
+	result, _ = handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"cover_letter_id": "1",
+	}))
+	if !result.IsError {
+		t.Errorf("Expected error deleting a missing cover letter, got %v", result.Content[0].(mcp.TextContent).Text)
+	}
+}
@@ -28,12 +28,20 @@ func NewUpdateTemplateTool(db *database.Database, templateService *service.Templ
 		mcp.WithString("template_data",
 			mcp.Description("New template data (optional)"),
 		),
+		mcp.WithString("change_note",
+			mcp.Description("Short note describing the change, recorded with the new revision of the template data (optional)"),
+		),
 	)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		user := types.GetAuthenticatedUser(ctx)
 		userID := &user.Sub
 
+		templateService, err := userTemplateService(db, templateService, userID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		templateIDStr, err := request.RequireString("template_id")
 		if err != nil {
 			return nil, fmt.Errorf("template_id parameter is required: %w", err)
@@ -60,27 +68,31 @@ func NewUpdateTemplateTool(db *database.Database, templateService *service.Templ
 			template.Description = description
 		}
 
+		var warning string
 		templateData := request.GetString("template_data", "")
 		if templateData != "" {
 			// Validate new template by testing it
-			resume, err := db.GetResumeByID(template.ResumeID, userID)
+			resume, err := templateValidationResume(db, template, userID)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Resume not found: %v", err)), nil
 			}
 
-			_, err = templateService.GeneratePreview(templateData, "", *resume)
+			output, err := templateService.RenderTemplate(templateData, template.Format, templateSampleData(template.Type, *resume))
 			if err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("Template validation failed: %v. Please check your Go template syntax and ensure all referenced fields exist on the resume model.", err)), nil
+				return mcp.NewToolResultError(fmt.Sprintf("Template validation failed: %v. Please check your Go template syntax and ensure all referenced fields exist on the %s model.", err, templateModelName(template.Type))), nil
 			}
+			warning = unsupportedClassesWarning(template.Format, output)
 
 			template.TemplateData = templateData
 		}
 
-		if err := db.UpdateTemplate(template, userID); err != nil {
+		changeNote := request.GetString("change_note", "")
+
+		if err := db.UpdateTemplateWithNote(template, changeNote, userID); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to update template: %v", err)), nil
 		}
 
-		return mcp.NewToolResultText("Template updated successfully"), nil
+		return mcp.NewToolResultText("Template updated successfully" + warning), nil
 	}
 
 	return tool, handler
@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+// Modes of promote_template
+const (
+	promoteModeCopy = "copy"
+	promoteModeMove = "move"
+)
+
+func NewPromoteTemplateTool(db *database.Database) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("promote_template",
+		mcp.WithDescription("Make a resume template a user template that can be used with any of the user's resumes, e.g. in generate_preview, render_pdf or generate_application_packet. Copy keeps the resume's template and creates a user template next to it, move turns the template itself into a user template."),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("ID of the template to promote"),
+		),
+		mcp.WithString("mode",
+			mcp.Description("copy or move (default: copy)"),
+			mcp.Enum(promoteModeCopy, promoteModeMove),
+		),
+		mcp.WithString("name",
+			mcp.Description("Name of the user template (default: the template's name)"),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		templateIDStr, err := request.RequireString("template_id")
+		if err != nil {
+			return nil, fmt.Errorf("template_id parameter is required: %w", err)
+		}
+
+		templateID, err := strconv.ParseUint(templateIDStr, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid template_id: %v", err)), nil
+		}
+
+		mode := request.GetString("mode", promoteModeCopy)
+		if mode != promoteModeCopy && mode != promoteModeMove {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid mode: %s, must be copy or move", mode)), nil
+		}
+
+		template, err := db.GetTemplateByID(uint(templateID), userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Template not found: %v", err)), nil
+		}
+
+		if template.IsUserTemplate() {
+			return mcp.NewToolResultError("Template is already a user template"), nil
+		}
+
+		name := request.GetString("name", template.Name)
+
+		if mode == promoteModeMove {
+			template.ResumeID = nil
+			template.Name = name
+			if err := db.UpdateTemplate(template, userID); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to update template: %v", err)), nil
+			}
+		} else {
+			template = &models.Template{
+				Name:         name,
+				Description:  template.Description,
+				Type:         template.Type,
+				Format:       template.Format,
+				TemplateData: template.TemplateData,
+			}
+			if err := db.CreateTemplate(template, userID); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to create template: %v", err)), nil
+			}
+		}
+
+		result := map[string]interface{}{
+			"template_id": template.ID,
+			"name":        template.Name,
+			"type":        template.Type,
+			"mode":        mode,
+		}
+		resultJSON, _ := json.Marshal(result)
+		return mcp.NewToolResultText(fmt.Sprintf("Template promoted to a user template successfully: %s", string(resultJSON))), nil
+	}
+
+	return tool, handler
+}
+
+// templateValidationResume returns the resume a template is validated against: the resume owning it,
+// or the sample resume for user templates
+func templateValidationResume(db *database.Database, template *models.Template, userID *string) (*models.Resume, error) {
+	if template.IsUserTemplate() {
+		resume := service.SampleResume()
+		return &resume, nil
+	}
+	return db.GetResumeByID(*template.ResumeID, userID)
+}
@@ -0,0 +1,224 @@
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+func TestPromoteTemplateTool_Copy(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	template := createTestTemplate(t, db, resume.ID)
+
+	tool, handler := NewPromoteTemplateTool(db)
+	if tool.Name != "promote_template" {
+		t.Errorf("Expected tool name 'promote_template', got %s", tool.Name)
+	}
+
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id": "1",
+		"name":        "Shared Layout",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got error: %v", result.Content[0].(mcp.TextContent).Text)
+	}
+
+	original, err := db.GetTemplateByID(template.ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get original template: %v", err)
+	}
+	if original.IsUserTemplate() || !original.UsableWith(resume.ID) {
+		t.Errorf("Expected original template to stay with resume %d, got %v", resume.ID, original.ResumeID)
+	}
+
+	userTemplates, err := db.ListUserTemplates(&testUserID)
+	if err != nil {
+		t.Fatalf("Failed to list user templates: %v", err)
+	}
+	if len(userTemplates) != 1 {
+		t.Fatalf("Expected 1 user template, got %d", len(userTemplates))
+	}
+	if userTemplates[0].Name != "Shared Layout" || userTemplates[0].TemplateData != template.TemplateData || !userTemplates[0].UsableWith(42) {
+		t.Errorf("Unexpected user template: %+v", userTemplates[0])
+	}
+}
+
+func TestPromoteTemplateTool_Move(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	template := createTestTemplate(t, db, resume.ID)
+
+	_, handler := NewPromoteTemplateTool(db)
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id": "1",
+		"mode":        "move",
+	}))
+	if err != nil || result.IsError {
+		t.Fatalf("Failed to move template: %v %v", err, result)
+	}
+
+	moved, err := db.GetTemplateByID(template.ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if !moved.IsUserTemplate() || moved.Name != template.Name {
+		t.Errorf("Expected template to become a user template, got %+v", moved)
+	}
+
+	resumeTemplates, err := db.ListTemplatesByResumeID(resume.ID, &testUserID)
+	if err != nil {
+		t.Fatalf("Failed to list templates: %v", err)
+	}
+	if len(resumeTemplates) != 0 {
+		t.Errorf("Expected no resume templates after move, got %d", len(resumeTemplates))
+	}
+
+	result, err = handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id": "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "already a user template") {
+		t.Errorf("Expected error promoting a user template, got %v", result.Content[0])
+	}
+}
+
+func TestPromoteTemplateTool_Errors(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	createTestTemplate(t, db, resume.ID)
+
+	_, handler := NewPromoteTemplateTool(db)
+
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		expected string
+	}{
+		{"invalid mode", map[string]interface{}{"template_id": "1", "mode": "share"}, "Invalid mode"},
+		{"unknown template", map[string]interface{}{"template_id": "99"}, "Template not found"},
+		{"invalid template id", map[string]interface{}{"template_id": "abc"}, "Invalid template_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler(createTestContext(), createTestRequest(tt.args))
+			if err != nil {
+				t.Fatalf("Handler returned error: %v", err)
+			}
+			if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, tt.expected) {
+				t.Errorf("Expected error containing %q, got %v", tt.expected, result.Content[0])
+			}
+		})
+	}
+}
+
+func TestUserTemplate_UsableWithAnyResume(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	templateService := service.NewTemplateService()
+	first := createTestResume(t, db)
+	second := &models.Resume{Name: "Second Resume"}
+	if err := db.CreateResume(second, &testUserID); err != nil {
+		t.Fatalf("Failed to create resume: %v", err)
+	}
+
+	userTemplate := &models.Template{Name: "Shared", TemplateData: "<h1>{{.Name}}</h1>"}
+	if err := db.CreateTemplate(userTemplate, &testUserID); err != nil {
+		t.Fatalf("Failed to create user template: %v", err)
+	}
+
+	_, previewHandler := NewGeneratePreviewTool(db, "8080", templateService)
+	for _, resumeID := range []string{"1", "2"} {
+		result, err := previewHandler(createTestContext(), createTestRequest(map[string]interface{}{
+			"resume_id":   resumeID,
+			"template_id": "1",
+		}))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if result.IsError {
+			t.Errorf("Expected user template to preview resume %s, got %v", resumeID, result.Content[0])
+		}
+	}
+
+	// Resume templates keep being limited to their own resume
+	resumeTemplate := createTestTemplate(t, db, first.ID)
+	result, err := previewHandler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id":   "2",
+		"template_id": "2",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Errorf("Expected resume template %d to be rejected for another resume", resumeTemplate.ID)
+	}
+
+	_, listHandler := NewListTemplatesTool(db)
+	result, err = listHandler(createTestContext(), createTestRequest(map[string]interface{}{
+		"resume_id": "1",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	text := strings.TrimPrefix(result.Content[0].(mcp.TextContent).Text, "Templates listed successfully: ")
+	var listed struct {
+		Templates     []models.Template `json:"templates"`
+		UserTemplates []models.Template `json:"user_templates"`
+	}
+	if err := json.Unmarshal([]byte(text), &listed); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if len(listed.Templates) != 1 || len(listed.UserTemplates) != 1 || listed.UserTemplates[0].Name != "Shared" {
+		t.Errorf("Expected 1 resume template and the shared user template, got %+v", listed)
+	}
+}
+
+func TestUpdateTemplateTool_UserTemplate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	userTemplate := &models.Template{Name: "Shared", TemplateData: "<h1>{{.Name}}</h1>"}
+	if err := db.CreateTemplate(userTemplate, &testUserID); err != nil {
+		t.Fatalf("Failed to create user template: %v", err)
+	}
+
+	_, handler := NewUpdateTemplateTool(db, service.NewTemplateService())
+	result, err := handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id":   "1",
+		"template_data": `{{range .WorkExperiences}}<p>{{dateRange . "Jan 2006"}}</p>{{end}}`,
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected user template update to validate against the sample resume, got %v", result.Content[0])
+	}
+
+	result, err = handler(createTestContext(), createTestRequest(map[string]interface{}{
+		"template_id":   "1",
+		"template_data": "{{.Missing}}",
+	}))
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected invalid user template to fail validation")
+	}
+}
@@ -0,0 +1,108 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/resume-mcp/internal/database"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+	"github.com/rxtech-lab/resume-mcp/internal/tailwind"
+	"github.com/rxtech-lab/resume-mcp/internal/types"
+)
+
+func NewValidateTemplateTool(db *database.Database, templateService *service.TemplateService) (mcp.Tool, server.ToolHandlerFunc) {
+	tool := mcp.NewTool("validate_template",
+		mcp.WithDescription(`Check a template before using it. The template is rendered against generated resumes covering edge cases: empty sections, current jobs without an end date, partial dates, very long text and Unicode. Fields the template uses that don't exist on the resume models are reported even in branches the samples don't reach.
+
+Returns every issue with its kind (parse, execute or field), the template or partial it is in, its line and column, and for execute issues the samples that failed. unsupported_classes warns about classes that get no generated Tailwind styles, e.g. typos or utilities the generator doesn't cover; they don't make the template invalid, since the template's own CSS may style them. Pass either template_id to validate a stored template or template_data to validate a draft.`),
+		mcp.WithString("template_id",
+			mcp.Description("ID of a stored template to validate"),
+		),
+		mcp.WithString("template_data",
+			mcp.Description("Go template string to validate instead of a stored template"),
+		),
+		mcp.WithString("type",
+			mcp.Description("Type of template_data: resume or cover_letter (default: resume). Stored templates use their own type"),
+			mcp.Enum(models.TemplateTypeResume, models.TemplateTypeCoverLetter),
+		),
+		mcp.WithString("format",
+			mcp.Description("Format of template_data: html or latex (default: html). Stored templates use their own format"),
+			mcp.Enum(models.TemplateFormatHTML, models.TemplateFormatLaTeX),
+		),
+	)
+
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		user := types.GetAuthenticatedUser(ctx)
+		userID := &user.Sub
+
+		templateService, err := userTemplateService(db, templateService, userID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		templateData := request.GetString("template_data", "")
+		templateType := request.GetString("type", models.TemplateTypeResume)
+		format := request.GetString("format", models.TemplateFormatHTML)
+		var ownResume *models.Resume
+
+		if request.GetString("template_id", "") != "" {
+			if templateData != "" {
+				return mcp.NewToolResultError("Pass either template_id or template_data, not both"), nil
+			}
+			template, errResult, err := templateArgument(db, request, userID)
+			if errResult != nil || err != nil {
+				return errResult, err
+			}
+			templateData = template.TemplateData
+			templateType = template.Type
+			format = template.Format
+			if !template.IsUserTemplate() {
+				if ownResume, err = db.GetResumeByID(*template.ResumeID, userID); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Resume not found: %v", err)), nil
+				}
+			}
+		} else if templateData == "" {
+			return mcp.NewToolResultError("template_id or template_data is required"), nil
+		}
+
+		if templateType != models.TemplateTypeResume && templateType != models.TemplateTypeCoverLetter {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid type: %s, must be resume or cover_letter", templateType)), nil
+		}
+		if format != models.TemplateFormatHTML && format != models.TemplateFormatLaTeX {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid format: %s, must be html or latex", format)), nil
+		}
+
+		var samples []service.ValidationSample
+		if ownResume != nil {
+			samples = append(samples, service.ValidationSample{Name: "resume " + ownResume.Name, Data: templateSampleData(templateType, *ownResume)})
+		}
+		for _, sample := range service.EdgeCaseResumes() {
+			samples = append(samples, service.ValidationSample{Name: sample.Name, Data: templateSampleData(templateType, sample.Data.(models.Resume))})
+		}
+
+		validation := templateService.ValidateTemplateFormat(templateData, format, samples)
+
+		resultJSON, _ := json.Marshal(validation)
+		return mcp.NewToolResultText(string(resultJSON)), nil
+	}
+
+	return tool, handler
+}
+
+// unsupportedClassesWarning returns a warning about the classes of the rendered template that get no generated
+// Tailwind styles, to append to the result of the tools saving templates. LaTeX templates have no classes.
+func unsupportedClassesWarning(format, output string) string {
+	if format == models.TemplateFormatLaTeX {
+		return ""
+	}
+	unsupported := tailwind.Unsupported(output)
+	if len(unsupported) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\n\nWarning: these classes get no generated Tailwind styles unless the template's own CSS defines them: %s", strings.Join(unsupported, ", "))
+}
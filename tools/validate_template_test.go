@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/resume-mcp/internal/models"
+	"github.com/rxtech-lab/resume-mcp/internal/service"
+)
+
+func TestValidateTemplateTool_StoredTemplate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	resume := createTestResume(t, db)
+	if err := db.CreatePartial(&models.Partial{
+		Name:         "job",
+		TemplateData: "<h2>{{.JobTitle}}</h2>\n<p>{{.EndDate.Format \"2006\"}}</p>",
+	}, &testUserID); err != nil {
+		t.Fatalf("Failed to create partial: %v", err)
+	}
+	template := &models.Template{
+		ResumeID:     &resume.ID,
+		Name:         "Jobs",
+		TemplateData: "<h1>{{.Name}}</h1>\n{{range .WorkExperiences}}{{template \"job\" .}}{{.Salary}}{{end}}",
+	}
+	if err := db.CreateTemplate(template, &testUserID); err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+
+	tool, handler := NewValidateTemplateTool(db, service.NewTemplateService())
+	if tool.Name != "validate_template" {
+		t.Errorf("Expected tool name 'validate_template', got %s", tool.Name)
+	}
+
+	result := callTool(t, handler, map[string]interface{}{"template_id": "1"})
+	var validation service.TemplateValidation
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &validation); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+
+	if validation.Valid || len(validation.Issues) != 2 {
+		t.Fatalf("Expected two issues, got %+v", validation.Issues)
+	}
+	if validation.Samples[0] != "resume Test User" {
+		t.Errorf("Expected the template's resume to be validated first, got %v", validation.Samples)
+	}
+
+	field, execute := validation.Issues[0], validation.Issues[1]
+	if field.Kind != service.IssueField || field.Template != "resume" || field.Line != 2 || field.Message != "models.WorkExperience has no field or method Salary" {
+		t.Errorf("Unexpected field issue: %+v", field)
+	}
+	if execute.Kind != service.IssueExecute || execute.Template != "job" || execute.Line != 2 || len(execute.Samples) != 1 || execute.Samples[0] != "current jobs" {
+		t.Errorf("Unexpected execute issue: %+v", execute)
+	}
+}
+
+func TestValidateTemplateTool_Draft(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, handler := NewValidateTemplateTool(db, service.NewTemplateService())
+
+	result := callTool(t, handler, map[string]interface{}{
+		"template_data": `<p>{{.Recipient}}, {{.Resume.Name}}</p>{{range .Sections}}<p>{{.Body}}</p>{{end}}`,
+		"type":          models.TemplateTypeCoverLetter,
+	})
+	var validation service.TemplateValidation
+	if err := json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &validation); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if !validation.Valid {
+		t.Errorf("Expected cover letter template to be valid, got %+v", validation.Issues)
+	}
+
+	for _, args := range []map[string]interface{}{
+		{},
+		{"template_id": "1", "template_data": "<p></p>"},
+		{"template_id": "99"},
+	} {
+		result, err := handler(createTestContext(), createTestRequest(args))
+		if err != nil {
+			t.Fatalf("Handler returned error: %v", err)
+		}
+		if !result.IsError {
+			t.Errorf("Expected error for %v", args)
+		}
+	}
+}